@@ -0,0 +1,269 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alex-vit/wt/wiki"
+)
+
+// readInput reads terms from the file at path, or from stdin if path is empty.
+// Reading stdin can be interrupted, as on a terminal it lasts until Ctrl-D.
+func readInput(ctx context.Context, path string) ([]string, error) {
+	if path == "" {
+		return interruptible(ctx, func() ([]string, error) {
+			return readTerms(os.Stdin)
+		})
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return readTerms(file)
+}
+
+// readTerms returns the non-blank lines of r, trimmed.
+func readTerms(r io.Reader) ([]string, error) {
+	var terms []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if term := strings.TrimSpace(scanner.Text()); term != "" {
+			terms = append(terms, term)
+		}
+	}
+	return terms, scanner.Err()
+}
+
+type searchResult struct {
+	title string
+	err   error
+}
+
+// runBatch translates terms, printing a result per translation in input order
+// and the failures at the end. Returns the number of failed terms.
+//
+// Terms are searched for using settings.Jobs workers, unless they are exact
+// titles, then the language links of all found articles, and their Wikidata
+// items if asked for, are fetched in as few requests as possible. Wiktionary
+// entries are fetched one at a time, they can be large.
+func runBatch(ctx context.Context, client *wiki.Client, settings *Settings, terms []string, opts lookupOptions, f formatter) (failed int) {
+	lang := settings.SourceLanguage
+	var found []searchResult
+	if opts.exact {
+		found = make([]searchResult, len(terms))
+		for i, term := range terms {
+			found[i].title = term
+		}
+	} else {
+		found = searchAll(ctx, client, settings, terms)
+	}
+
+	if opts.wiktionary {
+		return printBatch(ctx, settings, terms, found, translateEntries(ctx, client, settings, found), f)
+	}
+
+	var titles []string
+	for _, result := range found {
+		if result.err == nil {
+			titles = append(titles, result.title)
+		}
+	}
+	chunks := (len(titles) + wiki.MaxTitles - 1) / wiki.MaxTitles
+	batchCtx, cancel := context.WithTimeout(ctx, time.Duration(settings.Timeout)*time.Duration(chunks))
+	pages, err := client.Pages(batchCtx, lang, titles)
+	cancel()
+	if err != nil && ctx.Err() == nil {
+		// not fatal, each term gets a second chance below
+		fmt.Fprintf(os.Stderr, "Failed to get language links in bulk: %v\n", err)
+	}
+
+	translations := make([]*wiki.Translation, len(terms))
+	for i, result := range found {
+		if ctx.Err() != nil {
+			break
+		}
+		if result.err != nil {
+			continue
+		}
+		page, ok := pages[result.title]
+		if !ok {
+			// not in bulk results: missing, or not cached offline;
+			// asking again on its own gives the reason
+			pageCtx, cancel := context.WithTimeout(ctx, time.Duration(settings.Timeout))
+			page, err = client.Page(pageCtx, lang, result.title)
+			cancel()
+			if err != nil {
+				found[i].err = err
+				continue
+			}
+		}
+		if page.Disambiguation {
+			found[i].err = &wiki.DisambiguationError{Lang: lang, Title: page.Title}
+			continue
+		}
+		translations[i] = wiki.NewTranslation(lang, page)
+	}
+	if ctx.Err() == nil {
+		useWikidata(ctx, client, settings, translations, found, opts.wikidata)
+	}
+	if opts.describe && ctx.Err() == nil {
+		// a request or more per language
+		describeCtx, cancel := context.WithTimeout(ctx, time.Duration(settings.Timeout)*time.Duration(len(settings.TargetLanguages)))
+		described, err := client.Describe(describeCtx, translations, settings.TargetLanguages)
+		cancel()
+		if err != nil && ctx.Err() == nil {
+			fmt.Fprintf(os.Stderr, "Failed to get descriptions: %v\n", err)
+		} else if err == nil {
+			translations = described
+		}
+	}
+	return printBatch(ctx, settings, terms, found, translations, f)
+}
+
+// printBatch prints the translations of terms in order, then the failures.
+// Returns the number of failed terms.
+func printBatch(ctx context.Context, settings *Settings, terms []string, found []searchResult, translations []*wiki.Translation, f formatter) (failed int) {
+	var failures []string
+	printed := 0
+	for i, t := range translations {
+		if ctx.Err() != nil {
+			break // interrupted, not a failure of the remaining terms
+		}
+		if err := found[i].err; err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", terms[i], err))
+			if ff, ok := f.(failureFormatter); ok {
+				if err := ff.formatFailure(os.Stdout, terms[i], err); err != nil {
+					log.Fatal(err)
+				}
+			}
+			continue
+		}
+		warnSection(terms[i], t)
+		// the output can't go on without a result, unlike a lookup
+		if err := f.format(os.Stdout, newResult(terms[i], t, settings.TargetLanguages)); err != nil {
+			log.Fatal(err)
+		}
+		printed++
+	}
+	if err := f.end(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+
+	if len(failures) > 0 {
+		if printed > 0 {
+			fmt.Fprintln(os.Stderr)
+		}
+		fmt.Fprintf(os.Stderr, "%d of %d terms failed:\n", len(failures), len(terms))
+		for _, failure := range failures {
+			fmt.Fprintln(os.Stderr, failure)
+		}
+	}
+	return len(failures)
+}
+
+// translateEntries gets the translations of the Wiktionary entries found,
+// one entry at a time, recording failures in found.
+func translateEntries(ctx context.Context, client *wiki.Client, settings *Settings, found []searchResult) []*wiki.Translation {
+	translations := make([]*wiki.Translation, len(found))
+	for i, result := range found {
+		if ctx.Err() != nil {
+			break
+		}
+		if result.err != nil {
+			continue
+		}
+		entryCtx, cancel := context.WithTimeout(ctx, time.Duration(settings.Timeout))
+		translations[i], found[i].err = client.TranslateEntry(entryCtx, settings.SourceLanguage, result.title)
+		cancel()
+	}
+	return translations
+}
+
+// useWikidata fills in missing languages of translations from the labels
+// of their Wikidata items. With replaceLinks, the language links are replaced
+// by the sitelinks of the items too, and failures are recorded in found.
+func useWikidata(ctx context.Context, client *wiki.Client, settings *Settings, translations []*wiki.Translation, found []searchResult, replaceLinks bool) {
+	var ids []string
+	for _, t := range translations {
+		if t == nil || t.WikidataID == "" {
+			continue
+		}
+		if replaceLinks || len(t.Missing(settings.TargetLanguages)) > 0 {
+			ids = append(ids, t.WikidataID)
+		}
+	}
+	if len(ids) == 0 && !replaceLinks {
+		return
+	}
+	chunks := (len(ids) + wiki.MaxTitles - 1) / wiki.MaxTitles
+	batchCtx, cancel := context.WithTimeout(ctx, time.Duration(settings.Timeout)*time.Duration(chunks))
+	entities, err := client.Entities(batchCtx, ids)
+	cancel()
+	if err != nil && ctx.Err() == nil {
+		fmt.Fprintf(os.Stderr, "Failed to get Wikidata items in bulk: %v\n", err)
+	}
+
+	for i, t := range translations {
+		if t == nil || ctx.Err() != nil {
+			continue
+		}
+		entity, ok := entities[t.WikidataID]
+		if !replaceLinks {
+			// labels are a bonus, no second chances
+			if ok {
+				translations[i] = t.WithLabels(entity, settings.TargetLanguages)
+			}
+			continue
+		}
+		if ok {
+			translations[i] = t.WithEntity(entity, settings.TargetLanguages)
+			continue
+		}
+		// no item, missing, or not cached offline
+		entityCtx, cancel := context.WithTimeout(ctx, time.Duration(settings.Timeout))
+		translations[i], found[i].err = client.WikidataTranslation(entityCtx, t, settings.TargetLanguages)
+		cancel()
+	}
+}
+
+// searchAll searches for terms using settings.Jobs workers, returning results
+// in the order of terms.
+func searchAll(ctx context.Context, client *wiki.Client, settings *Settings, terms []string) []searchResult {
+	results := make([]searchResult, len(terms))
+	indexes := make(chan int)
+	go func() {
+		defer close(indexes)
+		for i := range terms {
+			indexes <- i
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for range min(settings.Jobs, len(terms)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				// once interrupted, the remaining terms fail right away
+				searchCtx, cancel := context.WithTimeout(ctx, time.Duration(settings.Timeout))
+				title, _, err := client.Search(searchCtx, settings.SourceLanguage, terms[i])
+				cancel()
+				if errors.Is(err, context.DeadlineExceeded) {
+					err = fmt.Errorf("Timed out after %v", settings.Timeout)
+				}
+				results[i] = searchResult{title, err}
+			}
+		}()
+	}
+	wg.Wait()
+	return results
+}
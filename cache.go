@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/alex-vit/wt/wiki"
+)
+
+func printCacheStats(settings *Settings) {
+	cache := &wiki.FileCache{Dir: CacheDir()}
+	allStats, err := cache.Stats(time.Duration(settings.CacheTTL))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("%s (ttl %v):\n", cache.Dir, settings.CacheTTL)
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	var total wiki.CacheStats
+	for _, stats := range allStats {
+		fmt.Fprintf(tw, "%s\t%d entries\t%d expired\t%s\t\n", stats.Kind, stats.Entries, stats.Expired, formatBytes(stats.Bytes))
+		total.Entries += stats.Entries
+		total.Expired += stats.Expired
+		total.Bytes += stats.Bytes
+	}
+	fmt.Fprintf(tw, "total\t%d entries\t%d expired\t%s\t\n", total.Entries, total.Expired, formatBytes(total.Bytes))
+	tw.Flush()
+}
+
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -12,30 +12,51 @@ import (
 	"net/http"
 	"os"
 	"slices"
+	"strings"
 	"text/template"
 	"time"
 )
 
 const siteMatrixURL = "https://commons.wikimedia.org/w/api.php?action=sitematrix&smtype=language&format=json"
-const languagesGoPath = "languages.go"
+const languagesGoPath = "wiki/languages.go"
 
 type languagesGoTemplateData struct {
-	LastUpdated   string
-	LanguageCodes []string
+	LastUpdated string
+	Languages   []siteMatrixRespEntry
 }
 
+// languagesGoTemplate is the format of languagesGoPath. Until the next run,
+// that file is kept by hand in this format.
 const languagesGoTemplate = `// File generated by gen_languages.go; DO NOT EDIT.
 // Last updated: {{.LastUpdated}}
-package main
+package wiki
+
+// Language is a language that has wikis, as listed by
+// https://meta.wikimedia.org/wiki/Special:SiteMatrix.
+type Language struct {
+	Code string
+	// Name is what the language is called in itself, like "français".
+	Name string
+	// LocalName is what the language is called in English, like "French".
+	LocalName string
+	// Dir is the direction the language is written in, "ltr" or "rtl".
+	Dir string
+}
 
-var supportedLanguageCodes = map[string]struct{}{
-	{{range .LanguageCodes -}}
-	"{{.}}": struct{}{},
+var languages = map[string]Language{
+	{{range .Languages -}}
+	{{printf "%q" .Code}}: {Code: {{printf "%q" .Code}}, Name: {{printf "%q" .Name}}, LocalName: {{printf "%q" .LocalName}}, Dir: {{printf "%q" .Dir}}},
 	{{end}}
 }
 
+// LookupLanguage returns the language with the given wiki code, like "fr".
+func LookupLanguage(code string) (Language, bool) {
+	language, found := languages[code]
+	return language, found
+}
+
 func IsSupportedLanguage(code string) bool {
-	_, found := supportedLanguageCodes[code]
+	_, found := languages[code]
 	return found
 }
 
@@ -82,7 +103,10 @@ type siteMatrixResp struct {
 	SiteMatrix map[string]json.RawMessage `json:"sitematrix"`
 }
 type siteMatrixRespEntry struct {
-	Code string `json:"code"`
+	Code      string `json:"code"`
+	Name      string `json:"name"`
+	LocalName string `json:"localname"`
+	Dir       string `json:"dir"`
 }
 
 func main() {
@@ -111,7 +135,7 @@ func main() {
 		log.Fatalln(err)
 	}
 
-	var languageCodes []string
+	var languages []siteMatrixRespEntry
 	for key, rawJson := range resp.SiteMatrix {
 		// skip the
 		//	"count": 1049
@@ -125,13 +149,13 @@ func main() {
 			fmt.Println(err)
 			continue
 		}
-		languageCodes = append(languageCodes, entry.Code)
+		languages = append(languages, entry)
 	}
-	slices.Sort(languageCodes)
+	slices.SortFunc(languages, func(a, b siteMatrixRespEntry) int { return strings.Compare(a.Code, b.Code) })
 
 	templData := languagesGoTemplateData{
-		LastUpdated:   time.Now().Format(time.DateOnly),
-		LanguageCodes: languageCodes}
+		LastUpdated: time.Now().Format(time.DateOnly),
+		Languages:   languages}
 
 	templ, err := template.New("").Parse(languagesGoTemplate)
 	if err != nil {
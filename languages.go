@@ -1,382 +0,0 @@
-// File generated by gen_languages.go; DO NOT EDIT.
-// Last updated: 2025-09-01
-package main
-
-var supportedLanguageCodes = map[string]struct{}{
-	"aa":           struct{}{},
-	"ab":           struct{}{},
-	"ace":          struct{}{},
-	"ady":          struct{}{},
-	"af":           struct{}{},
-	"ak":           struct{}{},
-	"als":          struct{}{},
-	"alt":          struct{}{},
-	"am":           struct{}{},
-	"ami":          struct{}{},
-	"an":           struct{}{},
-	"ang":          struct{}{},
-	"ann":          struct{}{},
-	"anp":          struct{}{},
-	"ar":           struct{}{},
-	"arc":          struct{}{},
-	"ary":          struct{}{},
-	"arz":          struct{}{},
-	"as":           struct{}{},
-	"ast":          struct{}{},
-	"atj":          struct{}{},
-	"av":           struct{}{},
-	"avk":          struct{}{},
-	"awa":          struct{}{},
-	"ay":           struct{}{},
-	"az":           struct{}{},
-	"azb":          struct{}{},
-	"ba":           struct{}{},
-	"ban":          struct{}{},
-	"bar":          struct{}{},
-	"bat-smg":      struct{}{},
-	"bbc":          struct{}{},
-	"bcl":          struct{}{},
-	"bdr":          struct{}{},
-	"be":           struct{}{},
-	"be-tarask":    struct{}{},
-	"be-x-old":     struct{}{},
-	"bew":          struct{}{},
-	"bg":           struct{}{},
-	"bh":           struct{}{},
-	"bi":           struct{}{},
-	"bjn":          struct{}{},
-	"blk":          struct{}{},
-	"bm":           struct{}{},
-	"bn":           struct{}{},
-	"bo":           struct{}{},
-	"bpy":          struct{}{},
-	"br":           struct{}{},
-	"bs":           struct{}{},
-	"btm":          struct{}{},
-	"bug":          struct{}{},
-	"bxr":          struct{}{},
-	"ca":           struct{}{},
-	"cbk-zam":      struct{}{},
-	"cdo":          struct{}{},
-	"ce":           struct{}{},
-	"ceb":          struct{}{},
-	"ch":           struct{}{},
-	"cho":          struct{}{},
-	"chr":          struct{}{},
-	"chy":          struct{}{},
-	"ckb":          struct{}{},
-	"co":           struct{}{},
-	"cr":           struct{}{},
-	"crh":          struct{}{},
-	"cs":           struct{}{},
-	"csb":          struct{}{},
-	"cu":           struct{}{},
-	"cv":           struct{}{},
-	"cy":           struct{}{},
-	"da":           struct{}{},
-	"dag":          struct{}{},
-	"de":           struct{}{},
-	"dga":          struct{}{},
-	"din":          struct{}{},
-	"diq":          struct{}{},
-	"dsb":          struct{}{},
-	"dtp":          struct{}{},
-	"dty":          struct{}{},
-	"dv":           struct{}{},
-	"dz":           struct{}{},
-	"ee":           struct{}{},
-	"el":           struct{}{},
-	"eml":          struct{}{},
-	"en":           struct{}{},
-	"eo":           struct{}{},
-	"es":           struct{}{},
-	"et":           struct{}{},
-	"eu":           struct{}{},
-	"ext":          struct{}{},
-	"fa":           struct{}{},
-	"fat":          struct{}{},
-	"ff":           struct{}{},
-	"fi":           struct{}{},
-	"fiu-vro":      struct{}{},
-	"fj":           struct{}{},
-	"fo":           struct{}{},
-	"fon":          struct{}{},
-	"fr":           struct{}{},
-	"frp":          struct{}{},
-	"frr":          struct{}{},
-	"fur":          struct{}{},
-	"fy":           struct{}{},
-	"ga":           struct{}{},
-	"gag":          struct{}{},
-	"gan":          struct{}{},
-	"gcr":          struct{}{},
-	"gd":           struct{}{},
-	"gl":           struct{}{},
-	"glk":          struct{}{},
-	"gn":           struct{}{},
-	"gom":          struct{}{},
-	"gor":          struct{}{},
-	"got":          struct{}{},
-	"gpe":          struct{}{},
-	"gsw":          struct{}{},
-	"gu":           struct{}{},
-	"guc":          struct{}{},
-	"gur":          struct{}{},
-	"guw":          struct{}{},
-	"gv":           struct{}{},
-	"ha":           struct{}{},
-	"hak":          struct{}{},
-	"haw":          struct{}{},
-	"he":           struct{}{},
-	"hi":           struct{}{},
-	"hif":          struct{}{},
-	"ho":           struct{}{},
-	"hr":           struct{}{},
-	"hsb":          struct{}{},
-	"ht":           struct{}{},
-	"hu":           struct{}{},
-	"hy":           struct{}{},
-	"hyw":          struct{}{},
-	"hz":           struct{}{},
-	"ia":           struct{}{},
-	"iba":          struct{}{},
-	"id":           struct{}{},
-	"ie":           struct{}{},
-	"ig":           struct{}{},
-	"igl":          struct{}{},
-	"ii":           struct{}{},
-	"ik":           struct{}{},
-	"ilo":          struct{}{},
-	"inh":          struct{}{},
-	"io":           struct{}{},
-	"is":           struct{}{},
-	"it":           struct{}{},
-	"iu":           struct{}{},
-	"ja":           struct{}{},
-	"jam":          struct{}{},
-	"jbo":          struct{}{},
-	"jv":           struct{}{},
-	"ka":           struct{}{},
-	"kaa":          struct{}{},
-	"kab":          struct{}{},
-	"kbd":          struct{}{},
-	"kbp":          struct{}{},
-	"kcg":          struct{}{},
-	"kg":           struct{}{},
-	"kge":          struct{}{},
-	"ki":           struct{}{},
-	"kj":           struct{}{},
-	"kk":           struct{}{},
-	"kl":           struct{}{},
-	"km":           struct{}{},
-	"kn":           struct{}{},
-	"knc":          struct{}{},
-	"ko":           struct{}{},
-	"koi":          struct{}{},
-	"kr":           struct{}{},
-	"krc":          struct{}{},
-	"ks":           struct{}{},
-	"ksh":          struct{}{},
-	"ku":           struct{}{},
-	"kus":          struct{}{},
-	"kv":           struct{}{},
-	"kw":           struct{}{},
-	"ky":           struct{}{},
-	"la":           struct{}{},
-	"lad":          struct{}{},
-	"lb":           struct{}{},
-	"lbe":          struct{}{},
-	"lez":          struct{}{},
-	"lfn":          struct{}{},
-	"lg":           struct{}{},
-	"li":           struct{}{},
-	"lij":          struct{}{},
-	"lld":          struct{}{},
-	"lmo":          struct{}{},
-	"ln":           struct{}{},
-	"lo":           struct{}{},
-	"lrc":          struct{}{},
-	"lt":           struct{}{},
-	"ltg":          struct{}{},
-	"lv":           struct{}{},
-	"lzh":          struct{}{},
-	"mad":          struct{}{},
-	"mai":          struct{}{},
-	"map-bms":      struct{}{},
-	"mdf":          struct{}{},
-	"mg":           struct{}{},
-	"mh":           struct{}{},
-	"mhr":          struct{}{},
-	"mi":           struct{}{},
-	"min":          struct{}{},
-	"mk":           struct{}{},
-	"ml":           struct{}{},
-	"mn":           struct{}{},
-	"mni":          struct{}{},
-	"mnw":          struct{}{},
-	"mo":           struct{}{},
-	"mos":          struct{}{},
-	"mr":           struct{}{},
-	"mrj":          struct{}{},
-	"ms":           struct{}{},
-	"mt":           struct{}{},
-	"mus":          struct{}{},
-	"mwl":          struct{}{},
-	"my":           struct{}{},
-	"myv":          struct{}{},
-	"mzn":          struct{}{},
-	"na":           struct{}{},
-	"nah":          struct{}{},
-	"nan":          struct{}{},
-	"nap":          struct{}{},
-	"nds":          struct{}{},
-	"nds-nl":       struct{}{},
-	"ne":           struct{}{},
-	"new":          struct{}{},
-	"ng":           struct{}{},
-	"nia":          struct{}{},
-	"nl":           struct{}{},
-	"nn":           struct{}{},
-	"no":           struct{}{},
-	"nov":          struct{}{},
-	"nqo":          struct{}{},
-	"nr":           struct{}{},
-	"nrm":          struct{}{},
-	"nso":          struct{}{},
-	"nup":          struct{}{},
-	"nv":           struct{}{},
-	"ny":           struct{}{},
-	"oc":           struct{}{},
-	"olo":          struct{}{},
-	"om":           struct{}{},
-	"or":           struct{}{},
-	"os":           struct{}{},
-	"pa":           struct{}{},
-	"pag":          struct{}{},
-	"pam":          struct{}{},
-	"pap":          struct{}{},
-	"pcd":          struct{}{},
-	"pcm":          struct{}{},
-	"pdc":          struct{}{},
-	"pfl":          struct{}{},
-	"pi":           struct{}{},
-	"pih":          struct{}{},
-	"pl":           struct{}{},
-	"pms":          struct{}{},
-	"pnb":          struct{}{},
-	"pnt":          struct{}{},
-	"ps":           struct{}{},
-	"pt":           struct{}{},
-	"pwn":          struct{}{},
-	"qu":           struct{}{},
-	"rki":          struct{}{},
-	"rm":           struct{}{},
-	"rmy":          struct{}{},
-	"rn":           struct{}{},
-	"ro":           struct{}{},
-	"roa-rup":      struct{}{},
-	"roa-tara":     struct{}{},
-	"rsk":          struct{}{},
-	"ru":           struct{}{},
-	"rue":          struct{}{},
-	"rup":          struct{}{},
-	"rw":           struct{}{},
-	"sa":           struct{}{},
-	"sah":          struct{}{},
-	"sat":          struct{}{},
-	"sc":           struct{}{},
-	"scn":          struct{}{},
-	"sco":          struct{}{},
-	"sd":           struct{}{},
-	"se":           struct{}{},
-	"sg":           struct{}{},
-	"sgs":          struct{}{},
-	"sh":           struct{}{},
-	"shi":          struct{}{},
-	"shn":          struct{}{},
-	"shy":          struct{}{},
-	"si":           struct{}{},
-	"simple":       struct{}{},
-	"sk":           struct{}{},
-	"skr":          struct{}{},
-	"sl":           struct{}{},
-	"sm":           struct{}{},
-	"smn":          struct{}{},
-	"sn":           struct{}{},
-	"so":           struct{}{},
-	"sq":           struct{}{},
-	"sr":           struct{}{},
-	"srn":          struct{}{},
-	"ss":           struct{}{},
-	"st":           struct{}{},
-	"stq":          struct{}{},
-	"su":           struct{}{},
-	"sv":           struct{}{},
-	"sw":           struct{}{},
-	"syl":          struct{}{},
-	"szl":          struct{}{},
-	"szy":          struct{}{},
-	"ta":           struct{}{},
-	"tay":          struct{}{},
-	"tcy":          struct{}{},
-	"tdd":          struct{}{},
-	"te":           struct{}{},
-	"tet":          struct{}{},
-	"tg":           struct{}{},
-	"th":           struct{}{},
-	"ti":           struct{}{},
-	"tig":          struct{}{},
-	"tk":           struct{}{},
-	"tl":           struct{}{},
-	"tly":          struct{}{},
-	"tn":           struct{}{},
-	"to":           struct{}{},
-	"tpi":          struct{}{},
-	"tr":           struct{}{},
-	"trv":          struct{}{},
-	"ts":           struct{}{},
-	"tt":           struct{}{},
-	"tum":          struct{}{},
-	"tw":           struct{}{},
-	"ty":           struct{}{},
-	"tyv":          struct{}{},
-	"udm":          struct{}{},
-	"ug":           struct{}{},
-	"uk":           struct{}{},
-	"ur":           struct{}{},
-	"uz":           struct{}{},
-	"ve":           struct{}{},
-	"vec":          struct{}{},
-	"vep":          struct{}{},
-	"vi":           struct{}{},
-	"vls":          struct{}{},
-	"vo":           struct{}{},
-	"vro":          struct{}{},
-	"wa":           struct{}{},
-	"war":          struct{}{},
-	"wo":           struct{}{},
-	"wuu":          struct{}{},
-	"xal":          struct{}{},
-	"xh":           struct{}{},
-	"xmf":          struct{}{},
-	"yi":           struct{}{},
-	"yo":           struct{}{},
-	"yue":          struct{}{},
-	"za":           struct{}{},
-	"zea":          struct{}{},
-	"zgh":          struct{}{},
-	"zh":           struct{}{},
-	"zh-classical": struct{}{},
-	"zh-min-nan":   struct{}{},
-	"zh-yue":       struct{}{},
-	"zu":           struct{}{},
-}
-
-func IsSupportedLanguage(code string) bool {
-	_, found := supportedLanguageCodes[code]
-	return found
-}
-
-func UnsupportedLanguage(code string) bool {
-	return !IsSupportedLanguage(code)
-}
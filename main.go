@@ -1,196 +1,393 @@
 package main
 
 import (
-	"cmp"
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
-	"io"
 	"log"
-	"net/http"
-	"net/url"
 	"os"
+	"os/signal"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
 
-	"github.com/alex-vit/util"
+	"github.com/alex-vit/wt/wiki"
 )
 
+// Exit code when interrupted with Ctrl-C, same as shells use for SIGINT.
+const exitInterrupted = 130
+
 func main() {
 	if len(os.Args) < 2 {
 		exitUsage()
 	}
 
 	settings := LoadSettings()
-	var saveSettings, printSettings bool
+	if slices.Equal(os.Args[1:], []string{"cache", "stats"}) {
+		printCacheStats(settings)
+		return
+	}
+
+	var saveSettings, printSettings, noCache, clearCache, offline, batch, names, aliases bool
+	var inputPath, format, templateName string
+	var opts lookupOptions
 	var queryb strings.Builder
 	for _, arg := range os.Args[1:] {
 		if arg == "-save" {
 			saveSettings = true
 		} else if arg == "-settings" {
 			printSettings = true
+		} else if arg == "-no-cache" {
+			noCache = true
+		} else if arg == "-clear-cache" {
+			clearCache = true
+		} else if arg == "-offline" {
+			offline = true
+		} else if arg == "-batch" {
+			batch = true
+		} else if arg == "-names" {
+			names = true
+		} else if arg == "-describe" {
+			opts.describe = true
+		} else if arg == "-aliases" {
+			aliases = true
+		} else if arg == "-exact" {
+			opts.exact = true
+		} else if path, ok := strings.CutPrefix(arg, "-input="); ok {
+			inputPath = path
+		} else if name, ok := strings.CutPrefix(arg, "-format="); ok {
+			format = name
+		} else if site, ok := strings.CutPrefix(arg, "-site="); ok {
+			if !slices.Contains(sites, site) {
+				log.Fatalf("Unknown site %s, expected one of %v", site, sites)
+			}
+			opts.wiktionary = site == "wiktionary"
+		} else if backend, ok := strings.CutPrefix(arg, "-backend="); ok {
+			if !slices.Contains(backends, backend) {
+				log.Fatalf("Unknown backend %s, expected one of %v", backend, backends)
+			}
+			opts.wikidata = backend == "wikidata"
+		} else if name, ok := strings.CutPrefix(arg, "-save-template="); ok {
+			templateName = name
 		} else if code, ok := strings.CutPrefix(arg, "from="); ok {
 			settings.SourceLanguage = code
 		} else if codesStr, ok := strings.CutPrefix(arg, "to="); ok {
 			settings.TargetLanguages = strings.Split(codesStr, ",")
+		} else if timeoutStr, ok := strings.CutPrefix(arg, "-timeout="); ok {
+			timeout, err := time.ParseDuration(timeoutStr)
+			if err != nil {
+				log.Fatalf("Invalid timeout: %v", err)
+			}
+			settings.Timeout = Duration(timeout)
+		} else if jobsStr, ok := strings.CutPrefix(arg, "-jobs="); ok {
+			jobs, err := strconv.Atoi(jobsStr)
+			if err != nil || jobs < 1 {
+				log.Fatalf("Invalid jobs: %s, expected a positive number", jobsStr)
+			}
+			settings.Jobs = jobs
+		} else if nStr, ok := strings.CutPrefix(arg, "-candidates="); ok {
+			n, err := strconv.Atoi(nStr)
+			if err != nil || n < 1 {
+				log.Fatalf("Invalid candidates: %s, expected a positive number", nStr)
+			}
+			opts.candidates = n
+		} else if rpsStr, ok := strings.CutPrefix(arg, "-rps="); ok {
+			rps, err := strconv.ParseFloat(rpsStr, 64)
+			if err != nil || rps <= 0 {
+				log.Fatalf("Invalid rps: %s, expected a positive number", rpsStr)
+			}
+			settings.RequestsPerSecond = rps
 		} else {
 			queryb.WriteString(arg)
 			queryb.WriteByte(' ')
 		}
 	}
 	settings.Normalize()
+	if offline && noCache {
+		log.Fatal("-offline only works with the cache, drop -no-cache")
+	}
+	if templateName != "" {
+		if !isTemplate(format) {
+			log.Fatalf(`-save-template=%s needs a template, e.g. -format='{{.Lang}}\t{{.Title}}'`, templateName)
+		}
+		if slices.Contains(formatNames, templateName) {
+			log.Fatalf("Can't name a template %s, it's a built-in format", templateName)
+		}
+		if _, err := newTemplateFormatter(format); err != nil {
+			log.Fatal(err)
+		}
+		if settings.Templates == nil {
+			settings.Templates = map[string]string{}
+		}
+		settings.Templates[templateName] = format
+		saveSettings = true
+	}
 	if saveSettings {
-		settings.Save()
+		if err := settings.Save(); err != nil {
+			log.Fatalf("%v, not saving settings", err)
+		}
 	}
 	if printSettings {
 		fmt.Printf("%s:\n", SettingsPath())
 		settings.PrettyPrint(os.Stdout)
 	}
+	cache := &wiki.FileCache{Dir: CacheDir()}
+	if clearCache {
+		if err := cache.Clear(); err != nil {
+			log.Fatal(err)
+		}
+	}
 
 	query := strings.TrimSpace(queryb.String())
-	if query == "" {
-		settings.Save()
+	batchMode := batch || inputPath != ""
+	if batchMode && query != "" {
+		log.Fatalf(`Got both a query ("%s") and -batch or -input=, pick one`, query)
+	}
+	if batchMode && opts.candidates > 0 {
+		log.Fatal("-candidates= only works for a single query")
+	}
+	if opts.exact && opts.candidates > 0 {
+		log.Fatal("-exact and -candidates= don't go together, pick one")
+	}
+	if opts.wiktionary && opts.wikidata {
+		log.Fatal("-site=wiktionary has translations of its own, drop -backend=wikidata")
+	}
+	if opts.wiktionary && opts.describe {
+		log.Fatal("-describe only works for Wikipedia articles")
+	}
+	if opts.wiktionary && settings.SourceLanguage != wiki.WiktionaryLang {
+		log.Fatalf("-site=wiktionary only reads the translation tables of the %s Wiktionary, use from=%s", wiki.WiktionaryLang, wiki.WiktionaryLang)
+	}
+	if query == "" && !batchMode {
+		if err := settings.Save(); err != nil {
+			log.Fatalf("%v, not saving settings", err)
+		}
 		return
 	}
-
+	if err := settings.Validate(); err != nil {
+		// there's no wiki to search in, while unknown target languages only
+		// show up as not found
+		if !wiki.IsSupportedLanguage(settings.SourceLanguage) {
+			log.Fatal(err)
+		}
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
 	settings.TargetLanguages = slices.DeleteFunc(settings.TargetLanguages, func(lang string) bool {
 		return lang == settings.SourceLanguage
 	})
-
-	title, url, err := findTitle(settings.SourceLanguage, query)
+	f, err := newFormatter(format, formatOptions{
+		batch:     batchMode,
+		languages: append([]string{settings.SourceLanguage}, settings.TargetLanguages...),
+		names:     names,
+		aliases:   aliases,
+		describe:  opts.describe,
+		templates: settings.Templates,
+	})
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	links, err := getLangLinks(settings.SourceLanguage, title)
-	if err != nil {
-		log.Fatal(err)
-	}
+	// Ctrl-C cancels in-flight requests
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
 
-	// sort for binary search
-	slices.SortFunc(links, func(a, b LangLink) int { return cmp.Compare(a.Lang, b.Lang) })
+	client := wiki.NewClient()
+	client.Limiter = wiki.NewRateLimiter(settings.RequestsPerSecond)
+	if opts.wiktionary {
+		client.BaseURL = wiki.WiktionaryBaseURL
+	}
+	if !noCache {
+		client.Cache = cache
+		client.CacheTTL = time.Duration(settings.CacheTTL)
+		client.Offline = offline
+	}
 
-	fmt.Printf("%s: %-30s %s\n", settings.SourceLanguage, title, url) // "from" language is not included in lang links
-	for _, lang := range settings.TargetLanguages {
-		linkIdx, found := slices.BinarySearchFunc(links, lang, func(link LangLink, lang string) int {
-			return cmp.Compare(link.Lang, lang)
-		})
-		if !found {
-			fmt.Printf("%s: ???\n", lang)
-			continue
+	if batchMode {
+		terms, err := readInput(ctx, inputPath)
+		if err != nil {
+			if ctx.Err() != nil {
+				fmt.Fprintln(os.Stderr, "Interrupted")
+				os.Exit(exitInterrupted)
+			}
+			log.Fatal(err)
 		}
-		star := links[linkIdx].Star
-		if len(star) > 30 {
-			star = star[:27] + "..."
+		failed := runBatch(ctx, client, settings, terms, opts, f)
+		if ctx.Err() != nil {
+			fmt.Fprintln(os.Stderr, "Interrupted")
+			os.Exit(exitInterrupted)
 		}
-		fmt.Printf("%s: %-30s %s\n", lang, star, links[linkIdx].Url)
+		if failed > 0 {
+			os.Exit(1)
+		}
+		return
 	}
-}
-
-// Finds the matching article and returns its title and URL.
-// Uses opensearch API: https://www.mediawiki.org/wiki/API:Opensearch.
-func findTitle(lang, query string) (title, titleUrl string, err error) {
-	reqUrl := util.Must(url.Parse("https://" + lang + ".wikipedia.org/w/api.php?action=opensearch&format=json&redirects=resolve&limit=1"))
-	q := reqUrl.Query()
-	q.Set("search", query)
-	reqUrl.RawQuery = q.Encode()
-
-	req, _ := http.NewRequest("GET", reqUrl.String(), nil)
-	addUserAgent(req)
 
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return "", "", err
+	t, err := lookUp(ctx, client, settings, query, opts)
+	if err == nil && t == nil {
+		return // only listed candidates
 	}
-	defer resp.Body.Close()
-
-	loLoStr, err := listOfListsOfStrings(resp.Body)
 	if err != nil {
-		return "", "", fmt.Errorf("Failed to parse response: %w", err)
+		if ctx.Err() != nil {
+			fmt.Fprintln(os.Stderr, "Interrupted")
+			os.Exit(exitInterrupted)
+		}
+		log.Fatal(err)
 	}
-	if len(loLoStr) != 4 || len(loLoStr[1]) == 0 || len(loLoStr[3]) == 0 {
-		return "", "", fmt.Errorf("Malformed response. Expected a [4][1+]string, got: %v", loLoStr)
+	warnSection(query, t)
+	if err := f.format(os.Stdout, newResult(query, t, settings.TargetLanguages)); err != nil {
+		log.Fatal(err)
+	}
+	if err := f.end(os.Stdout); err != nil {
+		log.Fatal(err)
 	}
-
-	title = loLoStr[1][0]
-	titleUrl = loLoStr[3][0]
-
-	return title, titleUrl, nil
 }
 
-// Useful for parsing responses in the  format of `[ string | []string ]`.
-// Idea from: https://gist.github.com/crgimenes/c3b8b4fcce8529e9201f83c8da134f32.
-func listOfListsOfStrings(r io.Reader) ([][]string, error) {
-	var anyList []any
-	if err := json.NewDecoder(r).Decode(&anyList); err != nil {
-		return nil, err
-	}
-
-	strLists := make([][]string, 0, len(anyList))
-	for _, item := range anyList {
-		switch obj := item.(type) {
-		case string:
-			strLists = append(strLists, []string{obj})
-		case []any:
-			strList := make([]string, 0, len(obj))
-			for _, v := range obj {
-				if str, ok := v.(string); ok {
-					strList = append(strList, str)
-				} else {
-					return nil, fmt.Errorf("Expected a string but got %#v", v)
-				}
-			}
-			strLists = append(strLists, strList)
-		default:
-			return nil, fmt.Errorf("Expected string or []any but got %v", obj)
-		}
+// warnSection warns when query led to a section of an article rather than to
+// an article of its own, since the translations are of the whole article.
+func warnSection(query string, t *wiki.Translation) {
+	if section := t.Section(); section != "" {
+		fmt.Fprintf(os.Stderr, "Warning: \"%s\" leads to the \"%s\" section of \"%s\", translations are of the whole article\n", query, section, t.Title)
 	}
-
-	return strLists, nil
 }
 
-type LangLink struct {
-	Lang string `json:"lang"`
-	// LangName string `json:"langname"` // needs &llprop=langname
-	Star string `json:"*"`
-	Url  string `json:"url"` // needs &llprop=url
+// sites are the wikis to search: Wikipedia for articles, or Wiktionary for
+// words and the translation tables of their entries.
+var sites = []string{"wikipedia", "wiktionary"}
+
+// backends are where translations come from: the language links of the
+// article, or the sitelinks of its Wikidata item.
+var backends = []string{"langlinks", "wikidata"}
+
+type lookupOptions struct {
+	// exact takes queries for article titles rather than searching.
+	exact bool
+	// candidates > 0 lists that many matching articles to pick from.
+	candidates int
+	// wikidata translates using the sitelinks and labels of Wikidata items.
+	wikidata bool
+	// wiktionary looks up Wiktionary entries rather than Wikipedia articles.
+	wiktionary bool
+	// describe adds a line about each article.
+	describe bool
 }
 
-func getLangLinks(lang, title string) (langLinks []LangLink, err error) {
-	u := util.Must(url.Parse("https://" + lang + ".wikipedia.org/w/api.php?action=query&format=json&prop=langlinks&llprop=url&lllimit=max"))
-	q := u.Query()
-	q.Set("titles", title)
-	u.RawQuery = q.Encode()
+// lookUp translates query, which is the exact title of an article if
+// opts.exact, or the candidate picked out of the top ones if
+// opts.candidates > 0. Disambiguation pages are expanded into the articles
+// they list. Returns nil, nil if candidates were only listed.
+func lookUp(ctx context.Context, client *wiki.Client, settings *Settings, query string, opts lookupOptions) (*wiki.Translation, error) {
+	t, err := lookUpArticle(ctx, client, settings, query, opts)
+	if err != nil || t == nil {
+		return t, err
+	}
+	if opts.wikidata {
+		t, err = withTimeout(ctx, settings, query, func(ctx context.Context) (*wiki.Translation, error) {
+			return client.WikidataTranslation(ctx, t, settings.TargetLanguages)
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// labels are a bonus, the translation stands without them
+		t, err = withTimeout(ctx, settings, query, func(ctx context.Context) (*wiki.Translation, error) {
+			return client.FillFromWikidata(ctx, t, settings.TargetLanguages)
+		})
+		if err != nil && !errors.Is(err, wiki.ErrNotCached) && ctx.Err() == nil {
+			fmt.Fprintf(os.Stderr, "Failed to get Wikidata labels: %v\n", err)
+		}
+	}
 
-	req, _ := http.NewRequest("GET", u.String(), nil)
-	addUserAgent(req)
+	if opts.describe {
+		// same for descriptions
+		described, err := withTimeout(ctx, settings, query, func(ctx context.Context) ([]*wiki.Translation, error) {
+			return client.Describe(ctx, []*wiki.Translation{t}, settings.TargetLanguages)
+		})
+		if err != nil && ctx.Err() == nil {
+			fmt.Fprintf(os.Stderr, "Failed to get descriptions: %v\n", err)
+		} else if err == nil {
+			t = described[0]
+		}
+	}
+	return t, nil
+}
 
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, err
+// lookUpArticle is lookUp using the language links of the article, or the
+// translations of the Wiktionary entry.
+func lookUpArticle(ctx context.Context, client *wiki.Client, settings *Settings, query string, opts lookupOptions) (*wiki.Translation, error) {
+	translateTitle := client.TranslateTitle
+	if opts.wiktionary {
+		translateTitle = client.TranslateEntry
 	}
-	defer resp.Body.Close()
 
-	var langs struct {
-		Query struct {
-			Pages map[string]struct {
-				LangLinks []LangLink `json:"langlinks"`
-			} `json:"pages"`
-		} `json:"query"`
+	var t *wiki.Translation
+	var err error
+	if opts.exact {
+		t, err = withTimeout(ctx, settings, query, func(ctx context.Context) (*wiki.Translation, error) {
+			return translateTitle(ctx, settings.SourceLanguage, query)
+		})
+	} else if opts.candidates > 0 {
+		var candidate *wiki.Candidate
+		candidate, err = pickCandidate(ctx, client, settings, query, opts.candidates)
+		if err != nil || candidate == nil {
+			return nil, err
+		}
+		t, err = withTimeout(ctx, settings, candidate.Title, func(ctx context.Context) (*wiki.Translation, error) {
+			return translateTitle(ctx, settings.SourceLanguage, candidate.Title)
+		})
+	} else {
+		t, err = translate(ctx, client, settings, query, opts)
 	}
-	err = json.NewDecoder(resp.Body).Decode(&langs)
-	if err != nil {
-		return nil, err
+
+	var disambiguation *wiki.DisambiguationError
+	if errors.As(err, &disambiguation) {
+		return pickSense(ctx, client, settings, disambiguation)
 	}
+	return t, err
+}
 
-	// return the first and only map entry
-	for _, v := range langs.Query.Pages {
-		return v.LangLinks, nil
+// translate looks up query, giving up after the configured timeout.
+func translate(ctx context.Context, client *wiki.Client, settings *Settings, query string, opts lookupOptions) (*wiki.Translation, error) {
+	return withTimeout(ctx, settings, query, func(ctx context.Context) (*wiki.Translation, error) {
+		if opts.wiktionary {
+			return client.TranslateWord(ctx, settings.SourceLanguage, query)
+		}
+		return client.Translate(ctx, settings.SourceLanguage, query)
+	})
+}
+
+// withTimeout calls lookup, giving up on query after the configured timeout.
+func withTimeout[T any](ctx context.Context, settings *Settings, query string, lookup func(context.Context) (T, error)) (T, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(settings.Timeout))
+	defer cancel()
+
+	v, err := lookup(ctx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		err = fmt.Errorf("Timed out after %v looking up \"%s\"", settings.Timeout, query)
 	}
-	return nil, fmt.Errorf(`No results for "%s"`, title)
+	return v, err
 }
 
-func addUserAgent(req *http.Request) {
-	req.Header.Add("User-Agent", "wt 1.0 / wiki-translate / https://github.com/alex-vit/wt")
+// interruptible calls read, which may block on a terminal, giving up when
+// ctx is done. The read goes on in the background then, which is fine since
+// the program is about to exit.
+func interruptible[T any](ctx context.Context, read func() (T, error)) (T, error) {
+	type readResult struct {
+		v   T
+		err error
+	}
+	done := make(chan readResult, 1)
+	go func() {
+		v, err := read()
+		done <- readResult{v, err}
+	}()
+	select {
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	case r := <-done:
+		return r.v, r.err
+	}
 }
 
 func exitUsage() {
@@ -199,7 +396,9 @@ DESCRIPTION
 	Translate a term using Wikipedia's language links feature.
 
 USAGE
-	wt [from=lv] [to=en,fr,es] [-save] [multi word query]
+	wt [from=lv] [to=en,fr,es] [-timeout=10s] [-no-cache | -offline] [-format=text] [-describe] [-site=wikipedia] [-backend=langlinks] [-exact | -candidates=5] [-save] [multi word query]
+	wt [from=lv] [to=en,fr,es] [-timeout=10s] [-no-cache | -offline] [-site=wikipedia] [-backend=langlinks] [-exact] [-jobs=4] [-rps=5] -batch | -input=terms.txt
+	wt cache stats
 
 OPTIONS
 	Options affect the current query. If query is omitted, or if '-save' is specified,
@@ -207,10 +406,73 @@ OPTIONS
 
 	from=		set the search term language; add it to target languages
 	to=		set languages to translate to
+			languages are wiki codes like de, ISO 639 codes like deu, tags like pt-BR,
+			or names in English or in themselves like German or Deutsch
+			unknown languages are warned about, with similar codes, and aren't saved
+	-timeout=	give up on a query after this long, e.g. 30s or 1m (default 10s)
+	-jobs=		in batch mode, how many queries to look up at once (default 4)
+	-rps=		at most this many requests per second to Wikipedia (default 5)
+	-format=	how to print results:
+			text	aligned columns, long titles are shortened to fit the terminal (default)
+			json	a JSON object with full titles, or an array of them in batch mode,
+				with the query and the error for queries that failed
+			jsonl	same as json in batch mode, but an object per line
+			csv	a header row of language codes, then a row of the query and its titles per query,
+				with empty titles for queries that failed
+			tsv	same as csv, but tab separated
+			markdown	a table of languages and linked titles per query
+			html	same as markdown, as an HTML table
+			a text/template run for each language, e.g. '{{.Lang}}\t{{.Title}}', with fields
+			.Query .Lang .LangName .Title .URL .WikidataID .Found .LabelOnly .Description
+			the name of a template saved with -save-template=
+	-save-template=	save the -format= template under this name in the settings file
+	-site=		where to look the query up:
+			wikipedia	articles, for names and expressions (default)
+			wiktionary	entries of English words, translated by their translation tables (from=en only)
+	-backend=	where translations of Wikipedia articles come from:
+			langlinks	the language links of the article (default)
+			wikidata	the Wikipedia articles of the article's Wikidata item, which are
+					more complete
+	-candidates=	list this many matching articles with their descriptions. On a terminal,
+			asks which one to translate.
+
+	When a query leads to a disambiguation page, the articles it lists are shown instead, and
+	on a terminal, wt asks which one to translate.
+
+	Languages without an article get the label of the article's Wikidata item, if it has one,
+	marked "label only" (except in csv and tsv, which have titles alone) and linked to the item.
+
+	When a query leads to an article through a redirect, or its title had to be normalized,
+	the way there is shown, e.g. 'nyc → NYC → New York City'. If the redirect leads to a
+	section of an article, wt warns that the translations are of the whole article.
 
 FLAGS
 	-save		Save the from/to options to the settings file. Omitting the query also saves options to file.
 	-settings	Print the settings file path and contents.
+	-no-cache	Neither use nor update cached results for this query.
+	-clear-cache	Delete all cached results.
+	-offline	Answer only from cached results, even expired ones. Fails for queries that were never looked up.
+	-batch		Read queries from stdin, one per line, instead of the command line.
+			Results are printed in input order. Language links are fetched for up to
+			50 articles per request.
+	-input=		Like -batch, but read queries from the given file.
+	-exact		Treat the query as the exact title of an article instead of searching for it.
+			Redirects are still followed.
+	-describe	Show a line about each article under its title: the short description, or the first
+			sentence of the article if it has none.
+	-aliases	Also show the other names of labels from Wikidata, e.g. 'olu salāti / olu salāts (label only)',
+			and the other translations from Wiktionary.
+	-names		Show the names of languages in themselves and in English, e.g. 'fr (Français / French)'.
+			Markdown and html tables get a column for them.
+
+CACHE
+	Search and language link results are cached for 'cache_ttl' from the settings file
+	(default 168h, i.e. a week). 'wt cache stats' shows where the cache is and what it holds.
+
+EXIT CODES
+	0	success
+	1	error, including timeouts; in batch mode, at least one query failed
+	130	interrupted with Ctrl-C
 
 EXAMPLES
 	wt -settings		# print current settings, which is set to defaults for now
@@ -218,6 +480,18 @@ EXAMPLES
 	wt from=lv pelmeņi	# translate only this query from 'lv', leaving settings intact
 	wt from=en to=es,fr,de	# update 'from' and 'to' settings since no query was provided
 	wt coelho from=pt -save	# translate from 'pt', saving 'from=pt' to settings
+	wt to=German,pt-BR,zh-Hant,yue egg salad	# same as to=de,pt,zh,yue
+	wt -no-cache egg salad	# look up 'egg salad' again even if it was looked up recently
+	wt -offline egg salad	# translate 'egg salad' without network access, if it was looked up before
+	wt -input=menu.txt	# translate each line of menu.txt
+	ls | wt -batch		# translate each file name
+	wt -candidates=5 mercury	# pick between the planet, the element, the god...
+	wt -exact Mercury (planet)	# skip the search when the title is known
+	wt -backend=wikidata to=en,lv,ga egg salad	# Wikidata's articles and labels
+	wt -site=wiktionary -aliases to=de,fr,lv run	# translations of a word
+	wt -describe to=fr,lv mercury	# check that the translations are of the right thing
+	wt -format=json egg salad | jq -r '.languages[] | select(.found) | .title'
+	wt -format='{{.Lang}}\t{{.Title}}' -save-template=tab egg salad	# then: wt -format=tab rabbit
 `))
 	os.Exit(0)
 }
@@ -0,0 +1,405 @@
+package main
+
+import (
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/alex-vit/wt/wiki"
+)
+
+// result is what gets printed for a query.
+type result struct {
+	Query string `json:"query"`
+	// Normalized, RedirectedFrom and Section tell how the query led to
+	// Source.Title, if not directly.
+	Normalized     string       `json:"normalized,omitempty"`
+	RedirectedFrom string       `json:"redirected_from,omitempty"`
+	Section        string       `json:"section,omitempty"`
+	WikidataID     string       `json:"wikidata_id,omitempty"`
+	Source         resultLang   `json:"source"`
+	Languages      []resultLang `json:"languages"`
+}
+
+type resultLang struct {
+	Lang     string `json:"lang"`
+	LangName string `json:"lang_name,omitempty"`
+	Autonym  string `json:"autonym,omitempty"`
+	Title    string `json:"title,omitempty"`
+	Url      string `json:"url,omitempty"`
+	Found    bool   `json:"found"`
+	// LabelOnly is set when Title is the Wikidata label of the topic, for a
+	// language with no article on it. Url leads to the Wikidata item.
+	LabelOnly   bool     `json:"label_only,omitempty"`
+	Aliases     []string `json:"aliases,omitempty"`
+	Description string   `json:"description,omitempty"`
+}
+
+// titleSuffix is what follows the title in human readable formats: the
+// aliases if asked for, and a mark for titles that are only labels.
+func (l *resultLang) titleSuffix(aliases bool) string {
+	var suffix string
+	if aliases {
+		for _, alias := range l.Aliases {
+			suffix += " / " + alias
+		}
+	}
+	if l.LabelOnly {
+		suffix += labelOnlyMark
+	}
+	return suffix
+}
+
+const labelOnlyMark = " (label only)"
+
+// rtl tells if the language is written right to left.
+func (l *resultLang) rtl() bool {
+	language, _ := wiki.LookupLanguage(l.Lang)
+	return language.Dir == "rtl"
+}
+
+// Right to left text is shown in a right to left isolate, so that the lines
+// it's on don't get reordered around it, like the URL after it.
+// See https://www.unicode.org/reports/tr9/#Explicit_Directional_Isolates.
+const (
+	rightToLeftIsolate    = "\u2067"
+	popDirectionalIsolate = "\u2069"
+)
+
+// isolate returns s in a right to left isolate if the language is written
+// right to left. The label only mark is left out of it, being English.
+func (l *resultLang) isolate(s string) string {
+	if !l.rtl() || s == "" {
+		return s
+	}
+	mark := ""
+	if l.LabelOnly {
+		if text, found := strings.CutSuffix(s, labelOnlyMark); found {
+			s, mark = text, labelOnlyMark
+		}
+	}
+	return rightToLeftIsolate + s + popDirectionalIsolate + mark
+}
+
+func newResult(query string, t *wiki.Translation, targetLanguages []string) *result {
+	r := &result{
+		Query:      query,
+		Normalized: t.Normalized,
+		Section:    t.Section(),
+		WikidataID: t.WikidataID,
+		Source:     resultLang{Lang: t.Lang, Title: t.Title, Url: t.Url, Found: true, Description: t.Description},
+		Languages:  make([]resultLang, 0, len(targetLanguages)),
+	}
+	if t.Redirect != nil {
+		r.RedirectedFrom = t.Redirect.From
+	}
+	for _, lang := range targetLanguages {
+		link, found := t.Link(lang)
+		r.Languages = append(r.Languages, resultLang{
+			Lang:        lang,
+			LangName:    link.LangName,
+			Autonym:     link.Autonym,
+			Title:       link.Star,
+			Url:         link.Url,
+			Found:       found,
+			LabelOnly:   link.LabelOnly,
+			Aliases:     link.Aliases,
+			Description: link.Description,
+		})
+	}
+
+	r.Source.fillNames()
+	for i := range r.Languages {
+		r.Languages[i].fillNames()
+	}
+	return r
+}
+
+// fillNames fills in the names of the language that the API left out, like
+// those of the source language, from the language table.
+func (l *resultLang) fillNames() {
+	if language, found := wiki.LookupLanguage(l.Lang); found {
+		l.LangName = cmp.Or(l.LangName, language.LocalName)
+		l.Autonym = cmp.Or(l.Autonym, language.Name)
+	}
+}
+
+// names returns what the language is called in itself and in English, like
+// "Français / French", or just "English". Right to left autonyms are
+// isolated if isolate, see resultLang.isolate.
+func (l *resultLang) names(isolate bool) string {
+	autonym := l.Autonym
+	if r, size := utf8.DecodeRuneInString(autonym); r != utf8.RuneError {
+		// autonyms are lowercase where the language writes them so
+		autonym = string(unicode.ToTitle(r)) + autonym[size:]
+	}
+	if autonym == "" || autonym == l.LangName {
+		return l.LangName
+	}
+	if isolate {
+		autonym = l.isolate(autonym)
+	}
+	if l.LangName == "" {
+		return autonym
+	}
+	return autonym + " / " + l.LangName
+}
+
+// label returns the language code, followed by the names of the language if
+// withNames, like "fr (Français / French)", for text output.
+func (l *resultLang) label(withNames bool) string {
+	if names := l.names(true); withNames && names != "" {
+		return l.Lang + " (" + names + ")"
+	}
+	return l.Lang
+}
+
+// resolution returns the way from r.Query to r.Source.Title, like
+// "nyc → NYC → New York City#History", or "" if it was direct.
+func (r *result) resolution() string {
+	if r.Normalized == "" && r.RedirectedFrom == "" {
+		return ""
+	}
+	steps := []string{r.Query}
+	for _, title := range []string{r.Normalized, r.RedirectedFrom} {
+		if title != "" && title != steps[len(steps)-1] {
+			steps = append(steps, title)
+		}
+	}
+	target := r.Source.Title
+	if r.Section != "" {
+		target += "#" + r.Section
+	}
+	return strings.Join(append(steps, target), " → ")
+}
+
+// formatter prints results. Call format for each result, then end once.
+// Errors are those of templates and of writers that keep them, like
+// csv.Writer, after which the output is incomplete.
+type formatter interface {
+	format(w io.Writer, r *result) error
+	end(w io.Writer) error
+}
+
+// failureFormatter is a formatter that keeps a place for the queries that
+// failed, so that its output lines up with the input.
+type failureFormatter interface {
+	formatFailure(w io.Writer, query string, err error) error
+}
+
+// failedQuery is the JSON of a query that failed.
+type failedQuery struct {
+	Query string `json:"query"`
+	Error string `json:"error"`
+}
+
+var formatNames = []string{"text", "json", "jsonl", "csv", "tsv", "markdown", "html"}
+
+type formatOptions struct {
+	// batch tells if there may be more than one result.
+	batch bool
+	// languages are the source language, then the target languages.
+	languages []string
+	// names adds the names of languages, in themselves and in English.
+	names bool
+	// aliases adds the other names of labels to human readable formats.
+	aliases bool
+	// describe adds descriptions of articles to formats that have no room
+	// for them otherwise.
+	describe  bool
+	templates map[string]string
+}
+
+// newFormatter returns the formatter for a -format= value: one of
+// formatNames, the name of one of the saved templates, or a template.
+func newFormatter(name string, opts formatOptions) (formatter, error) {
+	switch name {
+	case "", "text":
+		return &textFormatter{termWidth: terminalWidth(os.Stdout), names: opts.names, aliases: opts.aliases}, nil
+	case "json":
+		return &jsonFormatter{array: opts.batch}, nil
+	case "jsonl":
+		return jsonlFormatter{}, nil
+	case "csv":
+		return &csvFormatter{comma: ',', languages: opts.languages}, nil
+	case "tsv":
+		return &csvFormatter{comma: '\t', languages: opts.languages}, nil
+	case "markdown":
+		return &markdownFormatter{opts: opts}, nil
+	case "html":
+		return &htmlFormatter{opts: opts}, nil
+	}
+	if text, found := opts.templates[name]; found {
+		return newTemplateFormatter(text)
+	}
+	if isTemplate(name) {
+		return newTemplateFormatter(name)
+	}
+	return nil, fmt.Errorf("Unknown format %s, expected one of %v, a saved template or a template", name, formatNames)
+}
+
+// textFormatter prints a block of aligned lines per result, after the way
+// from the query to the article if it wasn't direct, and with descriptions
+// under titles if there are any:
+//
+//	en: Egg salad             https://en.wikipedia.org/wiki/Egg_salad
+//	    Salad made with eggs
+//	es: Ensaladilla de huevos https://es.wikipedia.org/wiki/Ensaladilla_de_huevos
+//
+// The title column is as wide as the longest title. On a terminal, titles are
+// shortened to keep lines from wrapping, as long as a third of the width is
+// left for titles. Right to left titles and descriptions are isolated, see
+// resultLang.isolate.
+type textFormatter struct {
+	// termWidth is 0 when not printing to a terminal.
+	termWidth int
+	names     bool
+	aliases   bool
+	count     int
+}
+
+func (f *textFormatter) format(w io.Writer, r *result) error {
+	if f.count > 0 {
+		fmt.Fprintln(w)
+	}
+	f.count++
+	if resolution := r.resolution(); resolution != "" {
+		fmt.Fprintln(w, resolution)
+	}
+
+	rows := append([]resultLang{r.Source}, r.Languages...) // "from" language is not included in lang links
+	langWidth, titleWidth, urlWidth := 0, 0, 0
+	titles := make([]string, len(rows))
+	for i, l := range rows {
+		titles[i] = l.Title + l.titleSuffix(f.aliases)
+		langWidth = max(langWidth, displayWidth(l.label(f.names)))
+		titleWidth = max(titleWidth, displayWidth(titles[i]))
+		urlWidth = max(urlWidth, len(l.Url))
+	}
+	prefixWidth := langWidth + len(": ")
+	if f.termWidth > 0 {
+		available := f.termWidth - prefixWidth - len(" ") - urlWidth
+		titleWidth = min(titleWidth, max(available, f.termWidth/3))
+	}
+
+	for i, l := range rows {
+		prefix := padRight(l.label(f.names)+":", prefixWidth)
+		if !l.Found {
+			fmt.Fprintf(w, "%s???\n", prefix)
+			continue
+		}
+		title := l.isolate(truncate(titles[i], titleWidth))
+		fmt.Fprintf(w, "%s%s %s\n", prefix, padRight(title, titleWidth), l.Url)
+		if l.Description != "" {
+			description := l.Description
+			if f.termWidth > 0 {
+				description = truncate(description, max(f.termWidth-prefixWidth, f.termWidth/3))
+			}
+			fmt.Fprintf(w, "%s%s\n", strings.Repeat(" ", prefixWidth), l.isolate(description))
+		}
+	}
+	return nil
+}
+
+func (f *textFormatter) end(w io.Writer) error { return nil }
+
+// jsonFormatter prints a JSON object, or an array of them in batch mode.
+// Queries that failed are objects of the query and the error.
+type jsonFormatter struct {
+	array   bool
+	results []any
+}
+
+func (f *jsonFormatter) format(w io.Writer, r *result) error {
+	if !f.array {
+		return printJson(w, r)
+	}
+	f.results = append(f.results, r)
+	return nil
+}
+
+func (f *jsonFormatter) formatFailure(w io.Writer, query string, err error) error {
+	f.results = append(f.results, failedQuery{query, err.Error()})
+	return nil
+}
+
+func (f *jsonFormatter) end(w io.Writer) error {
+	if !f.array {
+		return nil
+	}
+	results := f.results
+	if results == nil {
+		results = []any{} // [] rather than null when there were no terms
+	}
+	return printJson(w, results)
+}
+
+func printJson(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// jsonlFormatter prints a JSON object per line, see https://jsonlines.org.
+// Queries that failed are objects of the query and the error.
+type jsonlFormatter struct{}
+
+func (jsonlFormatter) format(w io.Writer, r *result) error {
+	return printJsonLine(w, r)
+}
+
+func (jsonlFormatter) formatFailure(w io.Writer, query string, err error) error {
+	return printJsonLine(w, failedQuery{query, err.Error()})
+}
+
+func printJsonLine(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	return enc.Encode(v)
+}
+
+func (jsonlFormatter) end(w io.Writer) error { return nil }
+
+// csvFormatter prints a header row of "query" and language codes, source
+// first, then a row of the query and its titles per result. Missing
+// translations, and all titles of queries that failed, are empty cells.
+// Cells are titles alone, so labels aren't told apart from articles there.
+type csvFormatter struct {
+	comma     rune
+	languages []string
+	csv       *csv.Writer
+}
+
+func (f *csvFormatter) format(w io.Writer, r *result) error {
+	row := []string{r.Query, r.Source.Title}
+	for _, l := range r.Languages {
+		row = append(row, l.Title)
+	}
+	return f.write(w, row)
+}
+
+func (f *csvFormatter) formatFailure(w io.Writer, query string, err error) error {
+	row := make([]string, 1+len(f.languages))
+	row[0] = query
+	return f.write(w, row)
+}
+
+func (f *csvFormatter) write(w io.Writer, row []string) error {
+	if f.csv == nil {
+		f.csv = csv.NewWriter(w)
+		f.csv.Comma = f.comma
+		f.csv.Write(append([]string{"query"}, f.languages...))
+	}
+	f.csv.Write(row)
+	f.csv.Flush()
+	return f.csv.Error()
+}
+
+func (f *csvFormatter) end(w io.Writer) error { return nil }
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/alex-vit/wt/wiki"
+)
+
+// pickCandidate lists up to n articles matching query. On a terminal, it asks
+// which one to translate, otherwise it only prints the list and returns nil.
+func pickCandidate(ctx context.Context, client *wiki.Client, settings *Settings, query string, n int) (*wiki.Candidate, error) {
+	candidates, err := withTimeout(ctx, settings, query, func(ctx context.Context) ([]wiki.Candidate, error) {
+		return client.Candidates(ctx, settings.SourceLanguage, query, n)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !isTerminal(os.Stdin) {
+		printCandidates(os.Stdout, candidates)
+		return nil, nil
+	}
+	printCandidates(os.Stderr, candidates)
+	return promptCandidate(ctx, os.Stdin, os.Stderr, candidates)
+}
+
+// pickSense lists the articles a disambiguation page links to. On a
+// terminal, it asks which one to translate, otherwise it returns de.
+func pickSense(ctx context.Context, client *wiki.Client, settings *Settings, de *wiki.DisambiguationError) (*wiki.Translation, error) {
+	candidates, err := withTimeout(ctx, settings, de.Title, func(ctx context.Context) ([]wiki.Candidate, error) {
+		return client.Disambiguation(ctx, de.Lang, de.Title)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintf(os.Stderr, "%v, did you mean:\n", de)
+	printCandidates(os.Stderr, candidates)
+	if !isTerminal(os.Stdin) {
+		return nil, de
+	}
+	candidate, err := promptCandidate(ctx, os.Stdin, os.Stderr, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return withTimeout(ctx, settings, candidate.Title, func(ctx context.Context) (*wiki.Translation, error) {
+		return client.TranslateTitle(ctx, de.Lang, candidate.Title)
+	})
+}
+
+// printCandidates prints a numbered list:
+//
+//  1. Mercury (planet) - Smallest and closest planet to the Sun
+//  2. Mercury (element) - Chemical element with atomic number 80
+func printCandidates(w io.Writer, candidates []wiki.Candidate) {
+	for i, candidate := range candidates {
+		fmt.Fprintf(w, "%2d. %s", i+1, candidate.Title)
+		if candidate.Description != "" {
+			fmt.Fprintf(w, " - %s", candidate.Description)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+// promptCandidate asks for the number of a candidate until it gets a valid
+// one, or ctx is done. Just Enter picks the first.
+func promptCandidate(ctx context.Context, in io.Reader, out io.Writer, candidates []wiki.Candidate) (*wiki.Candidate, error) {
+	if len(candidates) == 1 {
+		return &candidates[0], nil
+	}
+	reader := bufio.NewReader(in)
+	for {
+		fmt.Fprintf(out, "Pick 1-%d [1]: ", len(candidates))
+		line, err := interruptible(ctx, func() (string, error) {
+			return reader.ReadString('\n')
+		})
+		if ctx.Err() != nil {
+			fmt.Fprintln(out) // end the prompt line
+			return nil, ctx.Err()
+		}
+		line = strings.TrimSpace(line)
+		if line == "" && err == nil {
+			return &candidates[0], nil
+		}
+		if n, convErr := strconv.Atoi(line); convErr == nil && 1 <= n && n <= len(candidates) {
+			return &candidates[n-1], nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("No candidate picked: %w", err)
+		}
+	}
+}
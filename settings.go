@@ -2,13 +2,17 @@ package main
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"log"
 	"os"
 	"path/filepath"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/alex-vit/util"
+	"github.com/alex-vit/wt/wiki"
 )
 
 const (
@@ -16,29 +20,72 @@ const (
 	filename string = "settings.json"
 )
 
+const (
+	defaultTimeout  = 10 * time.Second
+	defaultCacheTTL = 7 * 24 * time.Hour
+	defaultJobs     = 4
+	// Wikimedia has no hard limit for reads, but asks to be gentle:
+	// https://www.mediawiki.org/wiki/API:Etiquette.
+	defaultRequestsPerSecond = 5
+)
+
 type Settings struct {
 	TargetLanguages []string `json:"target_languages"`
 	SourceLanguage  string   `json:"source_language"`
+	Timeout         Duration `json:"timeout"`
+	CacheTTL        Duration `json:"cache_ttl"`
+	// Jobs is how many terms are looked up at once in batch mode.
+	Jobs              int     `json:"jobs"`
+	RequestsPerSecond float64 `json:"requests_per_second"`
+	// Templates are -format= templates saved with -save-template=name,
+	// to be used as -format=name.
+	Templates map[string]string `json:"templates,omitempty"`
 }
 
+// Normalize turns language codes, tags and names into wiki codes, see
+// wiki.ResolveLanguage, and fills in defaults. Languages that can't be
+// resolved are kept as they are, for Validate to report.
 func (s *Settings) Normalize() {
-	if len(s.TargetLanguages) == 0 {
+	targets := make([]string, 0, len(s.TargetLanguages))
+	for _, lang := range s.TargetLanguages {
+		if code, ok := wiki.ResolveLanguage(lang); ok {
+			targets = append(targets, code)
+		} else if lang != "" {
+			targets = append(targets, lang)
+		}
+	}
+	if len(targets) == 0 {
 		s.TargetLanguages = []string{"en", "es", "fr"}
 	} else {
-		s.TargetLanguages = slices.DeleteFunc(s.TargetLanguages, UnsupportedLanguage)
-		slices.Sort(s.TargetLanguages)
-		s.TargetLanguages = slices.Compact(s.TargetLanguages)
+		slices.Sort(targets)
+		s.TargetLanguages = slices.Compact(targets)
+	}
+	if code, ok := wiki.ResolveLanguage(s.SourceLanguage); ok {
+		s.SourceLanguage = code
 	}
 	if s.SourceLanguage == "" {
-		if slices.Contains(s.TargetLanguages, "en") {
-			s.SourceLanguage = "en"
+		// a typo in to= mustn't become the language to search in
+		if i := slices.IndexFunc(s.TargetLanguages, wiki.IsSupportedLanguage); i >= 0 && !slices.Contains(s.TargetLanguages, "en") {
+			s.SourceLanguage = s.TargetLanguages[i]
 		} else {
-			s.SourceLanguage = s.TargetLanguages[0]
+			s.SourceLanguage = "en"
 		}
 	}
 	if i, found := slices.BinarySearch(s.TargetLanguages, s.SourceLanguage); !found {
 		s.TargetLanguages = slices.Insert(s.TargetLanguages, i, s.SourceLanguage)
 	}
+	if s.Timeout <= 0 {
+		s.Timeout = Duration(defaultTimeout)
+	}
+	if s.CacheTTL <= 0 {
+		s.CacheTTL = Duration(defaultCacheTTL)
+	}
+	if s.Jobs <= 0 {
+		s.Jobs = defaultJobs
+	}
+	if s.RequestsPerSecond <= 0 {
+		s.RequestsPerSecond = defaultRequestsPerSecond
+	}
 }
 
 func LoadSettings() *Settings {
@@ -56,13 +103,69 @@ func LoadSettings() *Settings {
 	return settings
 }
 
-func (s *Settings) Save() {
+// Save writes the settings to the settings file, unless they don't pass
+// Validate.
+func (s *Settings) Save() error {
+	s.Normalize()
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
 	util.Must(0, os.MkdirAll(settingsDir(), os.ModePerm))
 	file := util.Must(os.Create(SettingsPath()))
 	defer file.Close()
-
-	s.Normalize()
 	s.PrettyPrint(file)
+	return nil
+}
+
+// Validate returns a *LanguageError listing the languages of normalized
+// settings that aren't wiki codes.
+func (s *Settings) Validate() error {
+	var err LanguageError
+	if !wiki.IsSupportedLanguage(s.SourceLanguage) {
+		err.Unknown = append(err.Unknown, newUnknownLanguage("from", s.SourceLanguage))
+	}
+	for _, lang := range s.TargetLanguages {
+		// the source language is added to targets, no need to tell twice
+		if !wiki.IsSupportedLanguage(lang) && lang != s.SourceLanguage {
+			err.Unknown = append(err.Unknown, newUnknownLanguage("to", lang))
+		}
+	}
+	if len(err.Unknown) > 0 {
+		return &err
+	}
+	return nil
+}
+
+// LanguageError tells which languages of the settings are unknown.
+type LanguageError struct {
+	Unknown []UnknownLanguage
+}
+
+type UnknownLanguage struct {
+	// Setting is "from" or "to".
+	Setting string
+	Lang    string
+	// Suggestions are similar wiki codes, see wiki.SimilarLanguages.
+	Suggestions []string
+}
+
+func newUnknownLanguage(setting, lang string) UnknownLanguage {
+	return UnknownLanguage{Setting: setting, Lang: lang, Suggestions: wiki.SimilarLanguages(lang)}
+}
+
+func (e *LanguageError) Error() string {
+	parts := make([]string, len(e.Unknown))
+	for i, u := range e.Unknown {
+		parts[i] = u.Setting + "=" + u.Lang
+		if len(u.Suggestions) > 0 {
+			parts[i] += fmt.Sprintf(" (did you mean %s?)", strings.Join(u.Suggestions, ", "))
+		}
+	}
+	if len(parts) == 1 {
+		return "Unknown language " + parts[0]
+	}
+	return "Unknown languages " + strings.Join(parts, ", ")
 }
 
 func settingsDir() string {
@@ -73,8 +176,37 @@ func SettingsPath() string {
 	return filepath.Join(settingsDir(), filename)
 }
 
+// CacheDir is where lookup results are cached.
+func CacheDir() string {
+	return filepath.Join(util.Must(os.UserCacheDir()), dirName)
+}
+
 func (s *Settings) PrettyPrint(w io.Writer) {
 	enc := json.NewEncoder(w)
 	enc.SetIndent("", "  ")
 	util.Must(0, enc.Encode(s))
 }
+
+// Duration is a time.Duration stored in the settings file as a string like "10s".
+type Duration time.Duration
+
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	parsed, err := time.ParseDuration(str)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+)
+
+// markdownFormatter prints a table per result, headed by the query in batch
+// mode:
+//
+//	| Language | Title |
+//	| --- | --- |
+//	| en | [Egg salad](https://en.wikipedia.org/wiki/Egg_salad) |
+type markdownFormatter struct {
+	opts  formatOptions
+	count int
+}
+
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`, `[`, `\[`, `]`, `\]`, `*`, `\*`, `_`, `\_`, "`", "\\`", "<", "&lt;")
+
+func (f *markdownFormatter) format(w io.Writer, r *result) error {
+	if f.count > 0 {
+		fmt.Fprintln(w)
+	}
+	f.count++
+
+	if f.opts.batch {
+		fmt.Fprintf(w, "### %s\n\n", markdownEscaper.Replace(r.Query))
+	}
+	header, rule := "| Language |", "| --- |"
+	if f.opts.names {
+		header, rule = header+" Name |", rule+" --- |"
+	}
+	header, rule = header+" Title |", rule+" --- |"
+	if f.opts.describe {
+		header, rule = header+" Description |", rule+" --- |"
+	}
+	fmt.Fprintln(w, header)
+	fmt.Fprintln(w, rule)
+
+	for _, l := range append([]resultLang{r.Source}, r.Languages...) {
+		fmt.Fprintf(w, "| %s |", l.Lang)
+		if f.opts.names {
+			fmt.Fprintf(w, " %s |", markdownEscaper.Replace(l.names(false)))
+		}
+		if l.Found {
+			// parentheses would end the link early
+			url := strings.NewReplacer("(", "%28", ")", "%29").Replace(l.Url)
+			fmt.Fprintf(w, " [%s](%s)%s |", markdownEscaper.Replace(l.Title), url, markdownEscaper.Replace(l.titleSuffix(f.opts.aliases)))
+		} else {
+			fmt.Fprint(w, "  |")
+		}
+		if f.opts.describe {
+			fmt.Fprintf(w, " %s |", markdownEscaper.Replace(l.Description))
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+func (f *markdownFormatter) end(w io.Writer) error { return nil }
+
+// htmlFormatter prints a table per result, captioned by the query in batch
+// mode.
+type htmlFormatter struct {
+	opts formatOptions
+}
+
+func (f *htmlFormatter) format(w io.Writer, r *result) error {
+	fmt.Fprintln(w, "<table>")
+	if f.opts.batch {
+		fmt.Fprintf(w, "  <caption>%s</caption>\n", html.EscapeString(r.Query))
+	}
+	fmt.Fprint(w, "  <tr><th>Language</th>")
+	if f.opts.names {
+		fmt.Fprint(w, "<th>Name</th>")
+	}
+	fmt.Fprint(w, "<th>Title</th>")
+	if f.opts.describe {
+		fmt.Fprint(w, "<th>Description</th>")
+	}
+	fmt.Fprintln(w, "</tr>")
+
+	for _, l := range append([]resultLang{r.Source}, r.Languages...) {
+		// the lang attribute doesn't set the direction of text
+		attrs, dir := fmt.Sprintf(`lang="%s"`, html.EscapeString(l.Lang)), ""
+		if l.rtl() {
+			dir = ` dir="rtl"`
+		}
+		attrs += dir
+		fmt.Fprintf(w, "  <tr><td>%s</td>", html.EscapeString(l.Lang))
+		if f.opts.names {
+			fmt.Fprintf(w, "<td%s>%s</td>", dir, html.EscapeString(l.names(false)))
+		}
+		if l.Found {
+			fmt.Fprintf(w, `<td %s><a href="%s">%s</a>%s</td>`, attrs, html.EscapeString(l.Url), html.EscapeString(l.Title), html.EscapeString(l.titleSuffix(f.opts.aliases)))
+		} else {
+			fmt.Fprint(w, "<td></td>")
+		}
+		if f.opts.describe {
+			fmt.Fprintf(w, `<td %s>%s</td>`, attrs, html.EscapeString(l.Description))
+		}
+		fmt.Fprintln(w, "</tr>")
+	}
+	fmt.Fprintln(w, "</table>")
+	return nil
+}
+
+func (f *htmlFormatter) end(w io.Writer) error { return nil }
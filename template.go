@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+)
+
+// templateRow is what a -format= template can use, for example
+// '{{.Lang}}\t{{.Title}}'. Templates are run once per language, source first.
+type templateRow struct {
+	Query       string
+	Lang        string
+	LangName    string
+	Title       string
+	URL         string
+	WikidataID  string
+	Found       bool
+	LabelOnly   bool
+	Description string
+}
+
+// sampleRow is what templates are tried on before the first result. Its
+// values aren't empty, so that templates like '{{slice .Title 0 3}}' pass.
+var sampleRow = templateRow{
+	Query:       "egg salad",
+	Lang:        "en",
+	LangName:    "English",
+	Title:       "Egg salad",
+	URL:         "https://en.wikipedia.org/wiki/Egg_salad",
+	WikidataID:  "Q5347816",
+	Found:       true,
+	Description: "Salad made with eggs",
+}
+
+func isTemplate(format string) bool {
+	return strings.Contains(format, "{{")
+}
+
+// templateFormatter prints each language of a result with a text/template.
+type templateFormatter struct {
+	templ *template.Template
+}
+
+func newTemplateFormatter(text string) (*templateFormatter, error) {
+	text = unescape(text)
+	if !strings.HasSuffix(text, "\n") {
+		text += "\n"
+	}
+	templ, err := template.New("format").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid template: %w", err)
+	}
+	// catch unknown fields now rather than in the middle of the output
+	if err := templ.Execute(io.Discard, sampleRow); err != nil {
+		return nil, fmt.Errorf("Invalid template: %w", err)
+	}
+	return &templateFormatter{templ}, nil
+}
+
+func (f *templateFormatter) format(w io.Writer, r *result) error {
+	for _, l := range append([]resultLang{r.Source}, r.Languages...) {
+		// values can still fail where sampleRow didn't, like an index past
+		// the end of a short title
+		err := f.templ.Execute(w, templateRow{
+			Query:       r.Query,
+			Lang:        l.Lang,
+			LangName:    l.LangName,
+			Title:       l.Title,
+			URL:         l.Url,
+			WikidataID:  r.WikidataID,
+			Found:       l.Found,
+			LabelOnly:   l.LabelOnly,
+			Description: l.Description,
+		})
+		if err != nil {
+			return fmt.Errorf("Template failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (f *templateFormatter) end(w io.Writer) error { return nil }
+
+// unescape turns \t, \n and \\ outside of {{actions}} into the characters
+// they stand for, since shells pass them on as is.
+func unescape(text string) string {
+	unescaper := strings.NewReplacer(`\t`, "\t", `\n`, "\n", `\\`, `\`)
+	var b strings.Builder
+	for text != "" {
+		before, after, found := strings.Cut(text, "{{")
+		b.WriteString(unescaper.Replace(before))
+		if !found {
+			break
+		}
+		action, rest, found := strings.Cut(after, "}}")
+		if !found {
+			b.WriteString("{{" + after) // left for the template parser to complain about
+			break
+		}
+		b.WriteString("{{" + action + "}}")
+		text = rest
+	}
+	return b.String()
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"os"
+	"strconv"
+)
+
+// terminalWidth returns how many columns wide f is, or 0 if it's not a
+// terminal. $COLUMNS takes precedence, as with most tools.
+func terminalWidth(f *os.File) int {
+	if !isTerminal(f) {
+		return 0
+	}
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		return columns
+	}
+	return terminalColumns(f)
+}
@@ -0,0 +1,17 @@
+//go:build !(darwin || dragonfly || freebsd || linux || netbsd || openbsd)
+
+package main
+
+import "os"
+
+// isTerminal can't tell a terminal from other character devices like NUL
+// here, which is close enough.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// terminalColumns is unknown here, set $COLUMNS instead.
+func terminalColumns(f *os.File) int {
+	return 0
+}
@@ -0,0 +1,29 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+
+package main
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+type winsize struct{ rows, cols, xpixels, ypixels uint16 }
+
+// getWinsize asks the terminal driver for the window size, which fails for
+// anything but a terminal.
+func getWinsize(f *os.File) (winsize, bool) {
+	var size winsize
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), uintptr(syscall.TIOCGWINSZ), uintptr(unsafe.Pointer(&size)))
+	return size, errno == 0
+}
+
+func isTerminal(f *os.File) bool {
+	_, ok := getWinsize(f)
+	return ok
+}
+
+func terminalColumns(f *os.File) int {
+	size, _ := getWinsize(f)
+	return int(size.cols)
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"iter"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Terminals show most characters one column wide, East Asian wide
+// characters and emoji two columns wide, and combining marks and format
+// characters like the zero width joiner on top of their neighbours.
+// See https://www.unicode.org/reports/tr11/ and https://www.unicode.org/reports/tr29/.
+
+// displayWidth is how many terminal columns s takes.
+func displayWidth(s string) int {
+	width := 0
+	for cluster := range graphemes(s) {
+		width += clusterWidth(cluster)
+	}
+	return width
+}
+
+// truncate shortens s to at most width columns, ending it with "...".
+// It never splits a character, so accents and emoji stay whole.
+func truncate(s string, width int) string {
+	if displayWidth(s) <= width {
+		return s
+	}
+	const ellipsis = "..."
+	keep := max(width-len(ellipsis), 0)
+
+	var b strings.Builder
+	used := 0
+	for cluster := range graphemes(s) {
+		w := clusterWidth(cluster)
+		if used+w > keep {
+			break
+		}
+		b.WriteString(cluster)
+		used += w
+	}
+	if width >= len(ellipsis) {
+		b.WriteString(ellipsis)
+	}
+	return b.String()
+}
+
+// padRight adds spaces to s until it's width columns wide.
+func padRight(s string, width int) string {
+	return s + strings.Repeat(" ", max(width-displayWidth(s), 0))
+}
+
+// graphemes iterates over the user-perceived characters of s: a base
+// character along with any combining marks, variation selectors and emoji
+// modifiers after it, emoji joined by zero width joiners, and flag pairs.
+func graphemes(s string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		start := 0
+		var prev rune = -1
+		regionalIndicators := 0
+		for i, r := range s {
+			joined := i > start && (isExtending(r) ||
+				prev == zeroWidthJoiner ||
+				isHangulVowelOrTrailing(r) ||
+				isRegionalIndicator(r) && regionalIndicators%2 == 1)
+			if !joined && i > start {
+				if !yield(s[start:i]) {
+					return
+				}
+				start = i
+				regionalIndicators = 0
+			}
+			if isRegionalIndicator(r) {
+				regionalIndicators++
+			}
+			prev = r
+		}
+		if start < len(s) {
+			yield(s[start:])
+		}
+	}
+}
+
+const (
+	zeroWidthJoiner      = '\u200d'
+	emojiPresentation    = '\ufe0f' // variation selector 16
+	regionalIndicatorMin = 0x1F1E6
+	regionalIndicatorMax = 0x1F1FF
+)
+
+// isExtending tells if r attaches to the character before it.
+func isExtending(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc, unicode.Variation_Selector) ||
+		r == zeroWidthJoiner ||
+		0x1F3FB <= r && r <= 0x1F3FF || // emoji skin tone modifiers
+		0xE0020 <= r && r <= 0xE007F // emoji tag sequences
+}
+
+func isHangulVowelOrTrailing(r rune) bool {
+	return 0x1160 <= r && r <= 0x11FF || 0xD7B0 <= r && r <= 0xD7FF
+}
+
+func isRegionalIndicator(r rune) bool {
+	return regionalIndicatorMin <= r && r <= regionalIndicatorMax
+}
+
+func clusterWidth(cluster string) int {
+	base, _ := utf8.DecodeRuneInString(cluster)
+	if isRegionalIndicator(base) || strings.ContainsRune(cluster, emojiPresentation) {
+		return 2 // flags and emoji
+	}
+	return runeWidth(base)
+}
+
+func runeWidth(r rune) int {
+	switch {
+	case r == 0 || unicode.IsControl(r):
+		return 0
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) || isHangulVowelOrTrailing(r):
+		return 0
+	case isWide(r):
+		return 2
+	}
+	return 1
+}
+
+// wideRanges are the East Asian Wide and Fullwidth ranges, plus emoji
+// that are shown wide by default, from
+// https://www.unicode.org/Public/UCD/latest/ucd/EastAsianWidth.txt.
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F}, {0x231A, 0x231B}, {0x2329, 0x232A}, {0x23E9, 0x23EC},
+	{0x23F0, 0x23F0}, {0x23F3, 0x23F3}, {0x25FD, 0x25FE}, {0x2614, 0x2615},
+	{0x2648, 0x2653}, {0x267F, 0x267F}, {0x2693, 0x2693}, {0x26A1, 0x26A1},
+	{0x26AA, 0x26AB}, {0x26BD, 0x26BE}, {0x26C4, 0x26C5}, {0x26CE, 0x26CE},
+	{0x26D4, 0x26D4}, {0x26EA, 0x26EA}, {0x26F2, 0x26F3}, {0x26F5, 0x26F5},
+	{0x26FA, 0x26FA}, {0x26FD, 0x26FD}, {0x2705, 0x2705}, {0x270A, 0x270B},
+	{0x2728, 0x2728}, {0x274C, 0x274C}, {0x274E, 0x274E}, {0x2753, 0x2755},
+	{0x2757, 0x2757}, {0x2795, 0x2797}, {0x27B0, 0x27B0}, {0x27BF, 0x27BF},
+	{0x2B1B, 0x2B1C}, {0x2B50, 0x2B50}, {0x2B55, 0x2B55}, {0x2E80, 0x303E},
+	{0x3041, 0x33FF}, {0x3400, 0x4DBF}, {0x4E00, 0x9FFF}, {0xA000, 0xA4CF},
+	{0xA960, 0xA97F}, {0xAC00, 0xD7A3}, {0xF900, 0xFAFF}, {0xFE10, 0xFE19},
+	{0xFE30, 0xFE6F}, {0xFF00, 0xFF60}, {0xFFE0, 0xFFE6}, {0x16FE0, 0x16FE4},
+	{0x17000, 0x18CFF}, {0x1B000, 0x1B2FF}, {0x1F004, 0x1F004}, {0x1F0CF, 0x1F0CF},
+	{0x1F18E, 0x1F18E}, {0x1F191, 0x1F19A}, {0x1F200, 0x1F251}, {0x1F300, 0x1F320},
+	{0x1F32D, 0x1F335}, {0x1F337, 0x1F37C}, {0x1F37E, 0x1F393}, {0x1F3A0, 0x1F3CA},
+	{0x1F3CF, 0x1F3D3}, {0x1F3E0, 0x1F3F0}, {0x1F3F4, 0x1F3F4}, {0x1F3F8, 0x1F43E},
+	{0x1F440, 0x1F440}, {0x1F442, 0x1F4FC}, {0x1F4FF, 0x1F53D}, {0x1F54B, 0x1F54E},
+	{0x1F550, 0x1F567}, {0x1F57A, 0x1F57A}, {0x1F595, 0x1F596}, {0x1F5A4, 0x1F5A4},
+	{0x1F5FB, 0x1F64F}, {0x1F680, 0x1F6C5}, {0x1F6CC, 0x1F6CC}, {0x1F6D0, 0x1F6D2},
+	{0x1F6D5, 0x1F6D7}, {0x1F6DC, 0x1F6DF}, {0x1F6EB, 0x1F6EC}, {0x1F6F4, 0x1F6FC},
+	{0x1F7E0, 0x1F7EB}, {0x1F7F0, 0x1F7F0}, {0x1F90C, 0x1F93A}, {0x1F93C, 0x1F945},
+	{0x1F947, 0x1F9FF}, {0x1FA70, 0x1FAFF}, {0x20000, 0x2FFFD}, {0x30000, 0x3FFFD},
+}
+
+func isWide(r rune) bool {
+	_, found := slices.BinarySearchFunc(wideRanges, r, func(wr [2]rune, r rune) int {
+		switch {
+		case r < wr[0]:
+			return 1
+		case r > wr[1]:
+			return -1
+		}
+		return 0
+	})
+	return found
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	for s, want := range map[string]int{
+		"":                      0,
+		"Egg salad":             9,
+		"蛋沙律":                   6,
+		"🇫🇷🇩🇪":                  4,
+		"👩\u200d🔬":              2,
+		"❤\ufe0f":               2,
+		"Cafe\u0301":            4,
+		"\u2067סלט ביצים\u2069": 9,
+	} {
+		if got := displayWidth(s); got != want {
+			t.Errorf("displayWidth(%q) = %d, want %d", s, got, want)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	for _, tt := range []struct {
+		s     string
+		width int
+		want  string
+	}{
+		{"Egg salad", 9, "Egg salad"},
+		{"Egg salad", 6, "Egg..."},
+		{"Egg salad", 2, ""},
+		{"蛋沙律沙律", 7, "蛋沙..."},
+		{"蛋沙律沙律", 6, "蛋..."},
+		{"🇫🇷🇩🇪🇱🇻", 5, "🇫🇷..."},
+		{"👩\u200d🔬👩\u200d🔬", 4, "👩\u200d🔬👩\u200d🔬"},
+		{"👩\u200d🔬👩\u200d🔬", 3, "..."},
+		{"Cafe\u0301 au lait", 7, "Cafe\u0301..."},
+		{"סלט ביצים", 6, "סלט..."},
+		{"\u2067סלט ביצים\u2069", 9, "\u2067סלט ביצים\u2069"},
+	} {
+		got := truncate(tt.s, tt.width)
+		if got != tt.want {
+			t.Errorf("truncate(%q, %d) = %q, want %q", tt.s, tt.width, got, tt.want)
+		}
+		if !utf8.ValidString(got) {
+			t.Errorf("truncate(%q, %d) = %q, which isn't valid UTF-8", tt.s, tt.width, got)
+		}
+		if w := displayWidth(got); w > tt.width {
+			t.Errorf("truncate(%q, %d) = %q, %d columns wide", tt.s, tt.width, got, w)
+		}
+	}
+}
+
+func TestPadRight(t *testing.T) {
+	for _, tt := range []struct {
+		s     string
+		width int
+		want  string
+	}{
+		{"Egg", 5, "Egg  "},
+		{"Egg salad", 3, "Egg salad"},
+		{"蛋沙律", 8, "蛋沙律  "},
+		{"🇫🇷", 3, "🇫🇷 "},
+		{"Cafe\u0301", 5, "Cafe\u0301 "},
+		{"\u2067סלט\u2069", 5, "\u2067סלט\u2069  "},
+	} {
+		got := padRight(tt.s, tt.width)
+		if got != tt.want {
+			t.Errorf("padRight(%q, %d) = %q, want %q", tt.s, tt.width, got, tt.want)
+		}
+		if w := displayWidth(got); w != max(tt.width, displayWidth(tt.s)) {
+			t.Errorf("padRight(%q, %d) = %q, %d columns wide", tt.s, tt.width, got, w)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package wiki
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Cache stores API results between runs.
+// Keys look like "page/en.wikipedia.org/en/Egg salad": the kind of result,
+// the host of the API, the language and the title or query.
+type Cache interface {
+	// Get returns the data stored under key and when it was stored.
+	Get(key string) (data []byte, stored time.Time, ok bool)
+	Put(key string, data []byte) error
+}
+
+// ErrNotCached is returned in offline mode for results that were never fetched.
+var ErrNotCached = errors.New("Not cached")
+
+// cached decodes the cached kind of result for title into v, or calls fetch
+// to fill v and caches it.
+func (c *Client) cached(kind, lang, title string, v any, fetch func() error) error {
+	if c.fromCache(kind, lang, title, v) {
+		return nil
+	}
+	if c.Offline {
+		return fmt.Errorf(`%w: "%s" (%s)`, ErrNotCached, title, lang)
+	}
+
+	if err := fetch(); err != nil {
+		return err
+	}
+
+	c.toCache(kind, lang, title, v)
+	return nil
+}
+
+// fromCache decodes the cached kind of result for title into v, if there is
+// one that's fresh enough.
+func (c *Client) fromCache(kind, lang, title string, v any) bool {
+	if c.Cache == nil {
+		return false
+	}
+	data, stored, ok := c.Cache.Get(c.cacheKey(kind, lang, title))
+	// offline, stale results are better than none
+	fresh := c.Offline || c.CacheTTL <= 0 || time.Since(stored) < c.CacheTTL
+	return ok && fresh && json.Unmarshal(data, v) == nil
+}
+
+func (c *Client) toCache(kind, lang, title string, v any) {
+	if c.Cache == nil {
+		return
+	}
+	if data, err := json.Marshal(v); err == nil {
+		// a failed write only costs a refetch next time
+		_ = c.Cache.Put(c.cacheKey(kind, lang, title), data)
+	}
+}
+
+// cacheKey returns the key of the kind of result for title. Results without
+// a language come from Wikidata.
+func (c *Client) cacheKey(kind, lang, title string) string {
+	endpoint := c.WikidataURL
+	if lang != "" {
+		endpoint = c.endpoint(lang)
+	}
+	// a client can share its Cache with one of another site
+	host := endpoint
+	if u, err := url.Parse(endpoint); err == nil {
+		host = u.Host
+	}
+	return kind + "/" + host + "/" + lang + "/" + title
+}
+
+// FileCache is a Cache that keeps each entry in its own file under Dir,
+// grouped in subdirectories by format version and kind. The modification
+// time of a file is when it was stored.
+type FileCache struct {
+	Dir string
+}
+
+// fileCacheVersion is the subdirectory of Dir that entries are kept in. It
+// changes along with the format of entries, so that entries of older
+// versions are left for Clear rather than misread.
+const fileCacheVersion = "v2"
+
+func (fc *FileCache) path(key string) string {
+	kind, rest, _ := strings.Cut(key, "/")
+	sum := sha256.Sum256([]byte(rest))
+	return filepath.Join(fc.Dir, fileCacheVersion, kind, hex.EncodeToString(sum[:])+".json")
+}
+
+func (fc *FileCache) Get(key string) (data []byte, stored time.Time, ok bool) {
+	path := fc.path(key)
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+	data, err = os.ReadFile(path)
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+	return data, info.ModTime(), true
+}
+
+func (fc *FileCache) Put(key string, data []byte) error {
+	path := fc.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+
+	// write then rename, so that readers never see a partial entry
+	tmp, err := os.CreateTemp(filepath.Dir(path), "*.tmp")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Clear removes all entries.
+func (fc *FileCache) Clear() error {
+	return os.RemoveAll(fc.Dir)
+}
+
+// CacheStats describes the entries of one kind.
+type CacheStats struct {
+	Kind    string
+	Entries int
+	// Expired entries are older than the TTL passed to Stats.
+	Expired int
+	Bytes   int64
+}
+
+// Stats returns entry counts and sizes per kind, sorted by kind.
+// A ttl <= 0 means entries never expire.
+func (fc *FileCache) Stats(ttl time.Duration) ([]CacheStats, error) {
+	statsByKind := map[string]*CacheStats{}
+	root := filepath.Join(fc.Dir, fileCacheVersion)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".json" {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		kind := filepath.Base(filepath.Dir(path))
+		stats, ok := statsByKind[kind]
+		if !ok {
+			stats = &CacheStats{Kind: kind}
+			statsByKind[kind] = stats
+		}
+		stats.Entries++
+		stats.Bytes += info.Size()
+		if ttl > 0 && time.Since(info.ModTime()) >= ttl {
+			stats.Expired++
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	allStats := make([]CacheStats, 0, len(statsByKind))
+	for _, stats := range statsByKind {
+		allStats = append(allStats, *stats)
+	}
+	slices.SortFunc(allStats, func(a, b CacheStats) int { return strings.Compare(a.Kind, b.Kind) })
+	return allStats, nil
+}
@@ -0,0 +1,143 @@
+// Package wiki finds Wikipedia articles and translates their titles using
+// the language links between wikis.
+package wiki
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultBaseURL is the MediaWiki API endpoint of Wikipedia.
+	// "{lang}" is replaced with the wiki's language code.
+	DefaultBaseURL = "https://{lang}.wikipedia.org/w/api.php"
+	// DefaultWikidataURL is the MediaWiki API endpoint of Wikidata.
+	DefaultWikidataURL = "https://www.wikidata.org/w/api.php"
+	// DefaultUserAgent identifies wt, as asked by https://meta.wikimedia.org/wiki/User-Agent_policy.
+	DefaultUserAgent = "wt 1.0 / wiki-translate / https://github.com/alex-vit/wt"
+)
+
+// Client talks to the MediaWiki API. The zero value is not usable, use NewClient.
+type Client struct {
+	// BaseURL is the API endpoint template, "{lang}" is replaced with the language code.
+	BaseURL string
+	// WikidataURL is the API endpoint of Wikidata.
+	WikidataURL string
+	HTTPClient  *http.Client
+	UserAgent   string
+
+	// Cache, if set, keeps search and langlinks results between calls.
+	Cache Cache
+	// CacheTTL is how long cached results are used. Zero means forever.
+	CacheTTL time.Duration
+	// Offline answers only from Cache, regardless of CacheTTL, and fails
+	// with ErrNotCached otherwise.
+	Offline bool
+
+	// Limiter, if set, paces all requests.
+	Limiter *RateLimiter
+	// MaxRetries is how many times a request is retried when the server
+	// responds 429 Too Many Requests or 503 Service Unavailable.
+	MaxRetries int
+}
+
+func NewClient() *Client {
+	return &Client{
+		BaseURL:     DefaultBaseURL,
+		WikidataURL: DefaultWikidataURL,
+		HTTPClient:  http.DefaultClient,
+		UserAgent:   DefaultUserAgent,
+		MaxRetries:  3,
+	}
+}
+
+func apiURL(endpoint string, params url.Values) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("Invalid API URL: %w", err)
+	}
+	q := u.Query()
+	for k, vs := range params {
+		q[k] = vs
+	}
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// endpoint returns the API endpoint of the lang wiki.
+func (c *Client) endpoint(lang string) string {
+	return strings.ReplaceAll(c.BaseURL, "{lang}", lang)
+}
+
+// get calls the API of the lang wiki and decodes the JSON response into v.
+func (c *Client) get(ctx context.Context, lang string, params url.Values, v any) error {
+	return c.getFrom(ctx, c.endpoint(lang), params, v)
+}
+
+// getFrom calls the API at endpoint and decodes the JSON response into v.
+func (c *Client) getFrom(ctx context.Context, endpoint string, params url.Values, v any) error {
+	reqUrl, err := apiURL(endpoint, params)
+	if err != nil {
+		return err
+	}
+	resp, err := c.do(ctx, reqUrl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", reqUrl, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("Failed to parse response: %w", err)
+	}
+	return nil
+}
+
+// do sends a GET request, pacing it with Limiter and retrying as long as the
+// server asks to back off.
+func (c *Client) do(ctx context.Context, reqUrl string) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if c.Limiter != nil {
+			if err := c.Limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "GET", reqUrl, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Add("User-Agent", c.UserAgent)
+
+		resp, err := c.HTTPClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		tooMany := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+		if !tooMany || attempt >= c.MaxRetries {
+			return resp, nil
+		}
+		resp.Body.Close()
+
+		delay := retryAfter(resp, time.Second<<attempt)
+		if c.Limiter != nil {
+			// everyone backs off, not just this request
+			c.Limiter.Pause(delay)
+			continue
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
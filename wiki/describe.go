@@ -0,0 +1,180 @@
+package wiki
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// Descriptions returns the short descriptions of the given lang wiki
+// articles, like "Salad made with eggs" for Egg salad, keyed by the given
+// titles. Articles without a description are left out.
+// Uses https://www.mediawiki.org/wiki/Extension:ShortDescription.
+func (c *Client) Descriptions(ctx context.Context, lang string, titles []string) (map[string]string, error) {
+	prop := url.Values{"prop": {"description"}}
+	return c.describe(ctx, lang, titles, MaxTitles, prop, func(page describedPage) string {
+		return page.Description
+	})
+}
+
+// maxExtracts is how many extracts the API returns per request.
+const maxExtracts = 20
+
+// Extracts returns the first sentence of the given lang wiki articles as
+// plain text, keyed by the given titles. Articles without text are left out.
+// Uses https://www.mediawiki.org/wiki/Extension:TextExtracts.
+func (c *Client) Extracts(ctx context.Context, lang string, titles []string) (map[string]string, error) {
+	prop := url.Values{
+		"prop":        {"extracts"},
+		"exintro":     {"1"},
+		"exsentences": {"1"},
+		"explaintext": {"1"},
+		"exlimit":     {"max"},
+	}
+	return c.describe(ctx, lang, titles, maxExtracts, prop, func(page describedPage) string {
+		return strings.TrimSpace(page.Extract)
+	})
+}
+
+// Glosses returns a line about each of the given lang wiki articles, keyed
+// by the given titles: the short description, or the first sentence of
+// articles that have none. Articles without either are left out.
+func (c *Client) Glosses(ctx context.Context, lang string, titles []string) (map[string]string, error) {
+	glosses := make(map[string]string, len(titles))
+	var toFetch []string
+	for _, title := range titles {
+		var gloss string
+		if c.fromCache("gloss", lang, title, &gloss) {
+			if gloss != "" {
+				glosses[title] = gloss
+			}
+		} else if !c.Offline && !slices.Contains(toFetch, title) {
+			toFetch = append(toFetch, title)
+		}
+	}
+	if len(toFetch) == 0 {
+		return glosses, nil
+	}
+
+	descriptions, err := c.Descriptions(ctx, lang, toFetch)
+	if err != nil {
+		return nil, err
+	}
+	var undescribed []string
+	for _, title := range toFetch {
+		if _, found := descriptions[title]; !found {
+			undescribed = append(undescribed, title)
+		}
+	}
+	extracts := map[string]string{}
+	if len(undescribed) > 0 {
+		if extracts, err = c.Extracts(ctx, lang, undescribed); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, title := range toFetch {
+		gloss := descriptions[title]
+		if gloss == "" {
+			gloss = extracts[title]
+		}
+		// empty glosses are cached too, not to ask again
+		c.toCache("gloss", lang, title, gloss)
+		if gloss != "" {
+			glosses[title] = gloss
+		}
+	}
+	return glosses, nil
+}
+
+// Describe returns translations with the glosses of their articles, and of
+// the articles they link to in langs, filled in. See Glosses.
+func (c *Client) Describe(ctx context.Context, translations []*Translation, langs []string) ([]*Translation, error) {
+	titlesByLang := map[string][]string{}
+	for _, t := range translations {
+		if t == nil {
+			continue
+		}
+		titlesByLang[t.Lang] = append(titlesByLang[t.Lang], t.Title)
+		for _, lang := range langs {
+			// labels have no article to describe, and links may be to the
+			// wiki of another code for lang, see Translation.Link
+			if link, found := t.Link(lang); found && !link.LabelOnly {
+				titlesByLang[link.Lang] = append(titlesByLang[link.Lang], link.Star)
+			}
+		}
+	}
+
+	glossesByLang := make(map[string]map[string]string, len(titlesByLang))
+	for lang, titles := range titlesByLang {
+		glosses, err := c.Glosses(ctx, lang, titles)
+		if err != nil {
+			return nil, err
+		}
+		glossesByLang[lang] = glosses
+	}
+
+	described := make([]*Translation, len(translations))
+	for i, t := range translations {
+		if t == nil {
+			continue
+		}
+		dt := *t
+		dt.Description = glossesByLang[t.Lang][t.Title]
+		dt.LangLinks = slices.Clone(t.LangLinks)
+		for j, link := range dt.LangLinks {
+			if !link.LabelOnly {
+				dt.LangLinks[j].Description = glossesByLang[link.Lang][link.Star]
+			}
+		}
+		described[i] = &dt
+	}
+	return described, nil
+}
+
+type describedPage struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Extract     string `json:"extract"`
+}
+
+// describe asks for prop of the given lang wiki articles, chunkSize titles
+// per request, and returns what text picks out of each page, keyed by the
+// given titles. Empty texts are left out.
+func (c *Client) describe(ctx context.Context, lang string, titles []string, chunkSize int, prop url.Values, text func(describedPage) string) (map[string]string, error) {
+	texts := make(map[string]string, len(titles))
+	for chunk := range slices.Chunk(titles, chunkSize) {
+		params := url.Values{
+			"action":    {"query"},
+			"format":    {"json"},
+			"redirects": {"1"},
+			"titles":    {strings.Join(chunk, "|")},
+		}
+		for k, vs := range prop {
+			params[k] = vs
+		}
+		var resp struct {
+			Query struct {
+				Normalized []titleMapping           `json:"normalized"`
+				Redirects  []Redirect               `json:"redirects"`
+				Pages      map[string]describedPage `json:"pages"`
+			} `json:"query"`
+		}
+		if err := c.get(ctx, lang, params, &resp); err != nil {
+			return nil, err
+		}
+
+		textsByTitle := map[string]string{}
+		for _, page := range resp.Query.Pages {
+			textsByTitle[page.Title] = text(page)
+		}
+		for _, title := range chunk {
+			resolved, _, _ := resolveTitle(title, resp.Query.Normalized, resp.Query.Redirects)
+			if text := textsByTitle[resolved]; text != "" {
+				texts[title] = text
+			}
+		}
+	}
+	return texts, nil
+}
@@ -0,0 +1,95 @@
+package wiki
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// DisambiguationError is returned when a search leads to a disambiguation
+// page. Client.Disambiguation lists the articles it links to.
+type DisambiguationError struct {
+	Lang  string
+	Title string
+}
+
+func (e *DisambiguationError) Error() string {
+	return fmt.Sprintf(`"%s" is a disambiguation page`, e.Title)
+}
+
+// Disambiguation returns the articles that the lang wiki disambiguation page
+// titled title links to, sorted by title, along with their descriptions.
+// See https://www.mediawiki.org/wiki/Extension:Disambiguator.
+func (c *Client) Disambiguation(ctx context.Context, lang, title string) (candidates []Candidate, err error) {
+	err = c.cached("disambiguation", lang, title, &candidates, func() (err error) {
+		candidates, err = c.disambiguation(ctx, lang, title)
+		return err
+	})
+	return candidates, err
+}
+
+func (c *Client) disambiguation(ctx context.Context, lang, title string) ([]Candidate, error) {
+	params := url.Values{
+		"action":       {"query"},
+		"format":       {"json"},
+		"generator":    {"links"},
+		"gplnamespace": {"0"},
+		"gpllimit":     {"max"},
+		"prop":         {"description|info"},
+		"inprop":       {"url"},
+		"redirects":    {"1"},
+		"titles":       {title},
+	}
+
+	candidatesById := map[string]*Candidate{}
+	for {
+		var resp struct {
+			Continue map[string]string `json:"continue"`
+			Query    struct {
+				Pages map[string]struct {
+					Title       string  `json:"title"`
+					FullUrl     string  `json:"fullurl"`
+					Description string  `json:"description"`
+					Missing     *string `json:"missing"`
+				} `json:"pages"`
+			} `json:"query"`
+		}
+		if err := c.get(ctx, lang, params, &resp); err != nil {
+			return nil, err
+		}
+
+		for pageId, page := range resp.Query.Pages {
+			if page.Missing != nil {
+				continue // red link
+			}
+			candidate, seen := candidatesById[pageId]
+			if !seen {
+				candidate = &Candidate{Title: page.Title, Url: page.FullUrl}
+				candidatesById[pageId] = candidate
+			}
+			// each continuation can add what's missing from pages seen before
+			if page.Description != "" {
+				candidate.Description = page.Description
+			}
+		}
+
+		if len(resp.Continue) == 0 {
+			break
+		}
+		for k, v := range resp.Continue {
+			params.Set(k, v)
+		}
+	}
+
+	if len(candidatesById) == 0 {
+		return nil, fmt.Errorf(`No articles linked from "%s"`, title)
+	}
+	candidates := make([]Candidate, 0, len(candidatesById))
+	for _, candidate := range candidatesById {
+		candidates = append(candidates, *candidate)
+	}
+	slices.SortFunc(candidates, func(a, b Candidate) int { return strings.Compare(a.Title, b.Title) })
+	return candidates, nil
+}
@@ -0,0 +1,211 @@
+package wiki
+
+import (
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ResolveLanguage returns the wiki code of the language s stands for: a wiki
+// code like "fr", an ISO 639 code like "fra" or "fre", a BCP 47 tag like
+// "pt-BR" or "zh-Hant", or the name of the language in English or in itself,
+// like "German" or "Deutsch". Case doesn't matter.
+func ResolveLanguage(s string) (string, bool) {
+	tag := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(s)), "_", "-")
+	for tag != "" {
+		if IsSupportedLanguage(tag) {
+			return tag, true
+		}
+		if code, found := languageAliases[tag]; found {
+			return code, true
+		}
+		// BCP 47 subtags like the script or region narrow the language down
+		// more than wikis do
+		i := strings.LastIndexByte(tag, '-')
+		if i < 0 {
+			break
+		}
+		tag = tag[:i]
+	}
+	code, found := languageNames()[strings.ToLower(strings.TrimSpace(s))]
+	return code, found
+}
+
+// languageNames maps the lowercase English and native names of languages to
+// their wiki codes. Where languages share a name, the first code wins.
+var languageNames = sync.OnceValue(func() map[string]string {
+	codes := make([]string, 0, len(languages))
+	for code := range languages {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+
+	names := make(map[string]string, 2*len(languages))
+	for _, code := range codes {
+		for _, name := range []string{languages[code].LocalName, languages[code].Name} {
+			name = strings.ToLower(name)
+			if _, taken := names[name]; !taken && name != "" {
+				names[name] = code
+			}
+		}
+	}
+	return names
+})
+
+// sameLanguages pairs wiki codes that stand for the same language: the codes
+// of wikis created before there were ISO 639 codes for their languages, and
+// those codes, which wikis are being moved to.
+var sameLanguages = map[string]string{
+	"bat-smg":      "sgs",
+	"sgs":          "bat-smg",
+	"zh-yue":       "yue",
+	"yue":          "zh-yue",
+	"zh-min-nan":   "nan",
+	"nan":          "zh-min-nan",
+	"zh-classical": "lzh",
+	"lzh":          "zh-classical",
+	"roa-rup":      "rup",
+	"rup":          "roa-rup",
+	"fiu-vro":      "vro",
+	"vro":          "fiu-vro",
+	"be-x-old":     "be-tarask",
+	"be-tarask":    "be-x-old",
+	"als":          "gsw",
+	"gsw":          "als",
+}
+
+// languageAliases maps codes that aren't wiki codes to the wiki codes of
+// their languages: ISO 639-3 codes, ISO 639-2/B codes where they differ, the
+// codes of the individual languages wikis are written in for macrolanguages,
+// and ISO codes of languages whose wikis have codes of their own.
+var languageAliases = map[string]string{
+	"aar": "aa", "abk": "ab", "afr": "af", "aka": "ak", "amh": "am",
+	"arg": "an", "ara": "ar", "arb": "ar", "asm": "as", "ava": "av",
+	"aym": "ay", "aze": "az", "azj": "az", "bak": "ba", "bel": "be",
+	"bul": "bg", "bih": "bh", "bis": "bi", "bam": "bm", "ben": "bn",
+	"bod": "bo", "tib": "bo", "bre": "br", "bos": "bs", "cat": "ca",
+	"che": "ce", "cha": "ch", "cos": "co", "cre": "cr", "ces": "cs",
+	"cze": "cs", "chu": "cu", "chv": "cv", "cym": "cy", "wel": "cy",
+	"dan": "da", "deu": "de", "ger": "de", "div": "dv", "dzo": "dz",
+	"ewe": "ee", "ell": "el", "gre": "el", "eng": "en", "epo": "eo",
+	"spa": "es", "est": "et", "ekk": "et", "eus": "eu", "baq": "eu",
+	"fas": "fa", "per": "fa", "pes": "fa", "ful": "ff", "fin": "fi",
+	"fij": "fj", "fao": "fo", "fra": "fr", "fre": "fr", "fry": "fy",
+	"gle": "ga", "gla": "gd", "glg": "gl", "grn": "gn", "gug": "gn",
+	"guj": "gu", "glv": "gv", "hau": "ha", "heb": "he", "iw": "he",
+	"hin": "hi", "hmo": "ho", "hrv": "hr", "hat": "ht", "hun": "hu",
+	"hye": "hy", "arm": "hy", "her": "hz", "ina": "ia", "ind": "id",
+	"in": "id", "ile": "ie", "ibo": "ig", "iii": "ii", "ipk": "ik",
+	"ido": "io", "isl": "is", "ice": "is", "ita": "it", "iku": "iu",
+	"jpn": "ja", "jav": "jv", "kat": "ka", "geo": "ka", "kon": "kg",
+	"kik": "ki", "kua": "kj", "kaz": "kk", "kal": "kl", "khm": "km",
+	"kan": "kn", "kor": "ko", "kau": "kr", "kas": "ks", "kur": "ku",
+	"kmr": "ku", "kom": "kv", "cor": "kw", "kir": "ky", "lat": "la",
+	"ltz": "lb", "lug": "lg", "lim": "li", "lin": "ln", "lao": "lo",
+	"lit": "lt", "lav": "lv", "lvs": "lv", "mlg": "mg", "plt": "mg",
+	"mah": "mh", "mri": "mi", "mao": "mi", "mkd": "mk", "mac": "mk",
+	"mal": "ml", "mon": "mn", "khk": "mn", "mar": "mr", "msa": "ms",
+	"may": "ms", "zsm": "ms", "mlt": "mt", "mya": "my", "bur": "my",
+	"nau": "na", "nep": "ne", "npi": "ne", "ndo": "ng", "nld": "nl",
+	"dut": "nl", "nno": "nn", "nor": "no", "nob": "no", "nb": "no",
+	"nbl": "nr", "nav": "nv", "nya": "ny", "oci": "oc", "orm": "om",
+	"ori": "or", "ory": "or", "oss": "os", "pan": "pa", "pli": "pi",
+	"pol": "pl", "pus": "ps", "pbu": "ps", "por": "pt", "que": "qu",
+	"quz": "qu", "roh": "rm", "run": "rn", "ron": "ro", "rum": "ro",
+	"rus": "ru", "kin": "rw", "san": "sa", "srd": "sc", "snd": "sd",
+	"sme": "se", "sag": "sg", "hbs": "sh", "sin": "si", "slk": "sk",
+	"slo": "sk", "slv": "sl", "smo": "sm", "sna": "sn", "som": "so",
+	"sqi": "sq", "alb": "sq", "srp": "sr", "ssw": "ss", "sot": "st",
+	"sun": "su", "swe": "sv", "swa": "sw", "swh": "sw", "tam": "ta",
+	"tel": "te", "tgk": "tg", "tha": "th", "tir": "ti", "tuk": "tk",
+	"tgl": "tl", "tsn": "tn", "ton": "to", "tur": "tr", "tso": "ts",
+	"tat": "tt", "twi": "tw", "tah": "ty", "uig": "ug", "ukr": "uk",
+	"urd": "ur", "uzb": "uz", "uzn": "uz", "ven": "ve", "vie": "vi",
+	"vol": "vo", "wln": "wa", "wol": "wo", "xho": "xh", "yid": "yi",
+	"ydd": "yi", "ji": "yi", "yor": "yo", "zha": "za", "zho": "zh",
+	"chi": "zh", "cmn": "zh", "zul": "zu",
+	"cbk": "cbk-zam",
+	"egl": "eml",
+	"nrf": "nrm",
+}
+
+// maxSuggestions is how many codes SimilarLanguages returns at most.
+const maxSuggestions = 8
+
+// SimilarLanguages returns the wiki codes of the languages whose codes or
+// names are closest to s by edit distance, closest first, for suggestions
+// when ResolveLanguage finds no language. Languages that start like s go
+// first among those as close.
+func SimilarLanguages(s string) []string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	// short codes are all a letter or two apart from each other
+	maxDistance := 1
+	if len([]rune(s)) > 3 {
+		maxDistance = 2
+	}
+
+	type candidate struct {
+		code     string
+		distance int
+		initial  bool
+	}
+	var candidates []candidate
+	add := func(name, code string) {
+		if d := editDistance(s, name); d <= maxDistance {
+			initial := s != "" && name != "" && s[0] == name[0]
+			candidates = append(candidates, candidate{code, d, initial})
+		}
+	}
+	for code := range languages {
+		add(code, code)
+	}
+	for alias, code := range languageAliases {
+		add(alias, code)
+	}
+	for name, code := range languageNames() {
+		add(name, code)
+	}
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		if a.distance != b.distance {
+			return a.distance - b.distance
+		}
+		if a.initial != b.initial {
+			if a.initial {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.code, b.code)
+	})
+
+	var codes []string
+	for _, c := range candidates {
+		if !slices.Contains(codes, c.code) && len(codes) < maxSuggestions {
+			codes = append(codes, c.code)
+		}
+	}
+	return codes
+}
+
+// editDistance is the Levenshtein distance between a and b: how many runes
+// have to be inserted, deleted or replaced to turn one into the other.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := range ra {
+		curr[0] = i + 1
+		for j := range rb {
+			cost := 1
+			if ra[i] == rb[j] {
+				cost = 0
+			}
+			curr[j+1] = min(prev[j+1]+1, curr[j]+1, prev[j]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
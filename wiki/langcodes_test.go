@@ -0,0 +1,80 @@
+package wiki
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestResolveLanguage(t *testing.T) {
+	for s, want := range map[string]string{
+		"fr":        "fr",
+		"FR":        "fr",
+		"fra":       "fr",
+		"fre":       "fr",
+		"pt-BR":     "pt",
+		"zh_Hant":   "zh",
+		"nb":        "no",
+		"bat-smg":   "bat-smg",
+		"German":    "de",
+		"deutsch":   "de",
+		"be-tarask": "be-tarask",
+	} {
+		if got, ok := ResolveLanguage(s); !ok || got != want {
+			t.Errorf("ResolveLanguage(%q) = %q, %v, want %q", s, got, ok, want)
+		}
+	}
+	if got, ok := ResolveLanguage("fe"); ok {
+		t.Errorf(`ResolveLanguage("fe") = %q, want no language`, got)
+	}
+}
+
+func TestLinkToSameLanguage(t *testing.T) {
+	tr := &Translation{LangLinks: []LangLink{{Lang: "zh-yue", Star: "蛋沙律"}}}
+	if link, found := tr.Link("yue"); !found || link.Star != "蛋沙律" {
+		t.Errorf(`Got %+v, %v for "yue", want the zh-yue link`, link, found)
+	}
+}
+
+func TestDescribeLinkToSameLanguage(t *testing.T) {
+	descriptions := map[string]string{
+		"/en/api.php":     `{"batchcomplete": "", "query": {"pages": {"1": {"pageid": 1, "title": "Egg salad", "description": "Salad made with eggs"}}}}`,
+		"/zh-yue/api.php": `{"batchcomplete": "", "query": {"pages": {"2": {"pageid": 2, "title": "蛋沙律", "description": "沙律"}}}}`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp, found := descriptions[r.URL.Path]
+		if !found {
+			t.Errorf("Unexpected request to %s for %s", r.URL.Path, r.URL.Query().Get("titles"))
+			http.Error(w, "unexpected request", http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, resp)
+	}))
+	t.Cleanup(srv.Close)
+	client := NewClient()
+	client.BaseURL = srv.URL + "/{lang}/api.php"
+
+	tr := &Translation{Lang: "en", Title: "Egg salad", LangLinks: []LangLink{{Lang: "zh-yue", Star: "蛋沙律"}}}
+	described, err := client.Describe(context.Background(), []*Translation{tr}, []string{"yue"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if link, _ := described[0].Link("yue"); link.Description != "沙律" {
+		t.Errorf(`Got description %q of the zh-yue link, want "沙律"`, link.Description)
+	}
+}
+
+func TestSimilarLanguages(t *testing.T) {
+	if got := SimilarLanguages("fe"); !slices.Contains(got, "fr") {
+		t.Errorf(`Got %v for "fe", want fr among them`, got)
+	}
+	if got := SimilarLanguages("Germna"); len(got) == 0 || got[0] != "de" {
+		t.Errorf(`Got %v for "Germna", want de first`, got)
+	}
+	if got := SimilarLanguages("qqqqqq"); len(got) != 0 {
+		t.Errorf(`Got %v for "qqqqqq", want none`, got)
+	}
+}
@@ -0,0 +1,64 @@
+package wiki
+
+import (
+	"cmp"
+	"context"
+	"slices"
+)
+
+type LangLink struct {
+	Lang     string `json:"lang"`
+	LangName string `json:"langname"` // needs &llprop=langname
+	Autonym  string `json:"autonym"`  // needs &llprop=autonym
+	Star     string `json:"*"`
+	Url      string `json:"url"` // needs &llprop=url
+	// LabelOnly is set for links made of a Wikidata label, for languages
+	// with no article on the topic. Star is the label, Url leads to the item.
+	LabelOnly bool `json:"label_only,omitempty"`
+	// Aliases are the other names of the topic in Lang: the other labels of
+	// LabelOnly links, or the other translations listed by Wiktionary.
+	Aliases []string `json:"aliases,omitempty"`
+	// Description is a line about the article, see Client.Describe.
+	Description string `json:"description,omitempty"`
+}
+
+// LangLinks returns the links from the lang wiki article titled title to the
+// same article in other languages, sorted by language code.
+// Uses langlinks API: https://www.mediawiki.org/wiki/API:Langlinks.
+func (c *Client) LangLinks(ctx context.Context, lang, title string) ([]LangLink, error) {
+	page, err := c.Page(ctx, lang, title)
+	if err != nil {
+		return nil, err
+	}
+	return page.LangLinks, nil
+}
+
+// LangLinksBatch is LangLinks for many titles of the lang wiki, asking for up
+// to MaxTitles titles per request. The result is keyed by the given titles.
+// Missing pages, and in offline mode titles that aren't cached, are left out.
+func (c *Client) LangLinksBatch(ctx context.Context, lang string, titles []string) (map[string][]LangLink, error) {
+	pages, err := c.Pages(ctx, lang, titles)
+	if err != nil {
+		return nil, err
+	}
+	linksByTitle := make(map[string][]LangLink, len(pages))
+	for title, page := range pages {
+		linksByTitle[title] = page.LangLinks
+	}
+	return linksByTitle, nil
+}
+
+// withNames returns link with the names of its language filled in from the
+// language table, for links that don't come from the langlinks API.
+func withNames(link LangLink) LangLink {
+	if language, found := LookupLanguage(link.Lang); found {
+		link.LangName = cmp.Or(link.LangName, language.LocalName)
+		link.Autonym = cmp.Or(link.Autonym, language.Name)
+	}
+	return link
+}
+
+func sortLangLinks(links []LangLink) {
+	// sort for binary search
+	slices.SortFunc(links, func(a, b LangLink) int { return cmp.Compare(a.Lang, b.Lang) })
+}
@@ -0,0 +1,158 @@
+package wiki
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+// newTestClient returns a client of a stand-in API that answers the n-th
+// request with responses[n], and records the query parameters of each request.
+func newTestClient(t *testing.T, responses ...string) (*Client, *[]map[string]string) {
+	t.Helper()
+	var requests []map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		params := map[string]string{}
+		for k := range r.URL.Query() {
+			params[k] = r.URL.Query().Get(k)
+		}
+		requests = append(requests, params)
+		if len(requests) > len(responses) {
+			t.Errorf("Unexpected request #%d: %s", len(requests), r.URL.RawQuery)
+			http.Error(w, "unexpected request", http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, responses[len(requests)-1])
+	}))
+	t.Cleanup(srv.Close)
+
+	client := NewClient()
+	client.BaseURL = srv.URL + "/{lang}/api.php"
+	return client, &requests
+}
+
+func langs(links []LangLink) []string {
+	var codes []string
+	for _, link := range links {
+		codes = append(codes, link.Lang)
+	}
+	return codes
+}
+
+func TestLangLinksFollowsContinue(t *testing.T) {
+	client, requests := newTestClient(t,
+		`{"continue": {"llcontinue": "7|es", "continue": "||"},
+		  "query": {"pages": {"7": {"pageid": 7, "title": "Rabbit", "langlinks": [
+			{"lang": "de", "url": "https://de.wikipedia.org/wiki/Kaninchen", "*": "Kaninchen"}]}}}}`,
+		`{"continue": {"llcontinue": "7|lv", "continue": "||"},
+		  "query": {"pages": {"7": {"pageid": 7, "title": "Rabbit", "langlinks": [
+			{"lang": "es", "url": "https://es.wikipedia.org/wiki/Conejo", "*": "Conejo"},
+			{"lang": "fr", "url": "https://fr.wikipedia.org/wiki/Lapin", "*": "Lapin"}]}}}}`,
+		`{"batchcomplete": "",
+		  "query": {"pages": {"7": {"pageid": 7, "title": "Rabbit", "langlinks": [
+			{"lang": "lv", "url": "https://lv.wikipedia.org/wiki/Trusis", "*": "Trusis"}]}}}}`,
+	)
+
+	links, err := client.LangLinks(context.Background(), "en", "Rabbit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := langs(links), []string{"de", "es", "fr", "lv"}; !slices.Equal(got, want) {
+		t.Errorf("Got languages %v, want %v", got, want)
+	}
+
+	if len(*requests) != 3 {
+		t.Fatalf("Got %d requests, want 3", len(*requests))
+	}
+	if got := (*requests)[0]["llcontinue"]; got != "" {
+		t.Errorf("First request has llcontinue=%s", got)
+	}
+	for i, want := range []string{"7|es", "7|lv"} {
+		req := (*requests)[i+1]
+		if req["llcontinue"] != want || req["continue"] != "||" || req["titles"] != "Rabbit" {
+			t.Errorf("Request #%d doesn't continue from %s: %v", i+2, want, req)
+		}
+	}
+}
+
+func TestLangLinksMissingPage(t *testing.T) {
+	client, _ := newTestClient(t,
+		`{"batchcomplete": "", "query": {"pages": {"-1": {"ns": 0, "title": "Nonexistent", "missing": ""}}}}`,
+	)
+
+	_, err := client.LangLinks(context.Background(), "en", "Nonexistent")
+	if !errors.Is(err, ErrMissing) {
+		t.Fatalf("Got error %v, want ErrMissing", err)
+	}
+}
+
+func TestLangLinksBatch(t *testing.T) {
+	client, requests := newTestClient(t,
+		`{"continue": {"llcontinue": "2|fr", "continue": "||"},
+		  "query": {
+			"normalized": [{"from": "egg salad", "to": "Egg salad"}],
+			"redirects": [{"from": "NYC", "to": "New York City"}],
+			"pages": {
+				"1": {"pageid": 1, "title": "Egg salad", "langlinks": [
+					{"lang": "es", "url": "https://es.wikipedia.org/wiki/Ensaladilla_de_huevos", "*": "Ensaladilla de huevos"}]},
+				"2": {"pageid": 2, "title": "New York City", "langlinks": [
+					{"lang": "de", "url": "https://de.wikipedia.org/wiki/New_York_City", "*": "New York City"}]},
+				"-1": {"ns": 0, "title": "Nonexistent", "missing": ""}}}}`,
+		`{"batchcomplete": "",
+		  "query": {"pages": {
+			"1": {"pageid": 1, "title": "Egg salad"},
+			"2": {"pageid": 2, "title": "New York City", "langlinks": [
+				{"lang": "fr", "url": "https://fr.wikipedia.org/wiki/New_York", "*": "New York"}]},
+			"-1": {"ns": 0, "title": "Nonexistent", "missing": ""}}}}`,
+	)
+
+	linksByTitle, err := client.LangLinksBatch(context.Background(), "en", []string{"egg salad", "NYC", "Nonexistent", "NYC"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := (*requests)[0]["titles"]; got != "egg salad|NYC|Nonexistent" {
+		t.Errorf("Got titles=%s, want each title once", got)
+	}
+	if got, want := langs(linksByTitle["egg salad"]), []string{"es"}; !slices.Equal(got, want) {
+		t.Errorf("Got languages %v for normalized title, want %v", got, want)
+	}
+	if got, want := langs(linksByTitle["NYC"]), []string{"de", "fr"}; !slices.Equal(got, want) {
+		t.Errorf("Got languages %v for redirect, want %v", got, want)
+	}
+	if links, found := linksByTitle["Nonexistent"]; found {
+		t.Errorf("Got %v for a missing page, want no entry", links)
+	}
+}
+
+func TestPageRedirectToSection(t *testing.T) {
+	client, _ := newTestClient(t,
+		`{"batchcomplete": "",
+		  "query": {
+			"normalized": [{"from": "nyc history", "to": "Nyc history"}],
+			"redirects": [{"from": "Nyc history", "to": "New York City", "tofragment": "Early history"}],
+			"pages": {"645042": {"pageid": 645042, "title": "New York City",
+				"fullurl": "https://en.wikipedia.org/wiki/New_York_City", "langlinks": []}}}}`,
+	)
+
+	page, err := client.Page(context.Background(), "en", "nyc history")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Title != "New York City" || page.Normalized != "Nyc history" {
+		t.Errorf("Got title %s normalized from %s", page.Title, page.Normalized)
+	}
+	want := Redirect{From: "Nyc history", To: "New York City", Fragment: "Early history"}
+	if page.Redirect == nil || *page.Redirect != want {
+		t.Fatalf("Got redirect %+v, want %+v", page.Redirect, want)
+	}
+
+	translation := NewTranslation("en", page)
+	if got, want := translation.Url, "https://en.wikipedia.org/wiki/New_York_City#Early_history"; got != want {
+		t.Errorf("Got URL %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,402 @@
+// Language table in the format of gen_languages.go, with names and
+// directions added by hand rather than generated. Running gen_languages.go
+// replaces it.
+// Last updated: 2025-09-01
+package wiki
+
+// Language is a language that has wikis, as listed by
+// https://meta.wikimedia.org/wiki/Special:SiteMatrix.
+type Language struct {
+	Code string
+	// Name is what the language is called in itself, like "français".
+	Name string
+	// LocalName is what the language is called in English, like "French".
+	LocalName string
+	// Dir is the direction the language is written in, "ltr" or "rtl".
+	Dir string
+}
+
+var languages = map[string]Language{
+	"aa":           {Code: "aa", Name: "Qafár af", LocalName: "Afar", Dir: "ltr"},
+	"ab":           {Code: "ab", Name: "аԥсшәа", LocalName: "Abkhazian", Dir: "ltr"},
+	"ace":          {Code: "ace", Name: "Acèh", LocalName: "Acehnese", Dir: "ltr"},
+	"ady":          {Code: "ady", Name: "адыгабзэ", LocalName: "Adyghe", Dir: "ltr"},
+	"af":           {Code: "af", Name: "Afrikaans", LocalName: "Afrikaans", Dir: "ltr"},
+	"ak":           {Code: "ak", Name: "Akan", LocalName: "Akan", Dir: "ltr"},
+	"als":          {Code: "als", Name: "Alemannisch", LocalName: "Alemannic", Dir: "ltr"},
+	"alt":          {Code: "alt", Name: "алтай тил", LocalName: "Southern Altai", Dir: "ltr"},
+	"am":           {Code: "am", Name: "አማርኛ", LocalName: "Amharic", Dir: "ltr"},
+	"ami":          {Code: "ami", Name: "Pangcah", LocalName: "Amis", Dir: "ltr"},
+	"an":           {Code: "an", Name: "aragonés", LocalName: "Aragonese", Dir: "ltr"},
+	"ang":          {Code: "ang", Name: "Ænglisc", LocalName: "Old English", Dir: "ltr"},
+	"ann":          {Code: "ann", Name: "Obolo", LocalName: "Obolo", Dir: "ltr"},
+	"anp":          {Code: "anp", Name: "अंगिका", LocalName: "Angika", Dir: "ltr"},
+	"ar":           {Code: "ar", Name: "العربية", LocalName: "Arabic", Dir: "rtl"},
+	"arc":          {Code: "arc", Name: "ܐܪܡܝܐ", LocalName: "Aramaic", Dir: "rtl"},
+	"ary":          {Code: "ary", Name: "الدارجة", LocalName: "Moroccan Arabic", Dir: "rtl"},
+	"arz":          {Code: "arz", Name: "مصرى", LocalName: "Egyptian Arabic", Dir: "rtl"},
+	"as":           {Code: "as", Name: "অসমীয়া", LocalName: "Assamese", Dir: "ltr"},
+	"ast":          {Code: "ast", Name: "asturianu", LocalName: "Asturian", Dir: "ltr"},
+	"atj":          {Code: "atj", Name: "Atikamekw", LocalName: "Atikamekw", Dir: "ltr"},
+	"av":           {Code: "av", Name: "авар", LocalName: "Avaric", Dir: "ltr"},
+	"avk":          {Code: "avk", Name: "Kotava", LocalName: "Kotava", Dir: "ltr"},
+	"awa":          {Code: "awa", Name: "अवधी", LocalName: "Awadhi", Dir: "ltr"},
+	"ay":           {Code: "ay", Name: "Aymar aru", LocalName: "Aymara", Dir: "ltr"},
+	"az":           {Code: "az", Name: "azərbaycanca", LocalName: "Azerbaijani", Dir: "ltr"},
+	"azb":          {Code: "azb", Name: "تۆرکجه", LocalName: "South Azerbaijani", Dir: "rtl"},
+	"ba":           {Code: "ba", Name: "башҡортса", LocalName: "Bashkir", Dir: "ltr"},
+	"ban":          {Code: "ban", Name: "Basa Bali", LocalName: "Balinese", Dir: "ltr"},
+	"bar":          {Code: "bar", Name: "Boarisch", LocalName: "Bavarian", Dir: "ltr"},
+	"bat-smg":      {Code: "bat-smg", Name: "žemaitėška", LocalName: "Samogitian", Dir: "ltr"},
+	"bbc":          {Code: "bbc", Name: "Batak Toba", LocalName: "Batak Toba", Dir: "ltr"},
+	"bcl":          {Code: "bcl", Name: "Bikol Central", LocalName: "Central Bikol", Dir: "ltr"},
+	"bdr":          {Code: "bdr", Name: "Bajau Sama", LocalName: "West Coast Bajau", Dir: "ltr"},
+	"be":           {Code: "be", Name: "беларуская", LocalName: "Belarusian", Dir: "ltr"},
+	"be-tarask":    {Code: "be-tarask", Name: "беларуская (тарашкевіца)", LocalName: "Belarusian (Taraškievica orthography)", Dir: "ltr"},
+	"be-x-old":     {Code: "be-x-old", Name: "беларуская (тарашкевіца)", LocalName: "Belarusian (Taraškievica orthography)", Dir: "ltr"},
+	"bew":          {Code: "bew", Name: "Betawi", LocalName: "Betawi", Dir: "ltr"},
+	"bg":           {Code: "bg", Name: "български", LocalName: "Bulgarian", Dir: "ltr"},
+	"bh":           {Code: "bh", Name: "भोजपुरी", LocalName: "Bhojpuri", Dir: "ltr"},
+	"bi":           {Code: "bi", Name: "Bislama", LocalName: "Bislama", Dir: "ltr"},
+	"bjn":          {Code: "bjn", Name: "Banjar", LocalName: "Banjar", Dir: "ltr"},
+	"blk":          {Code: "blk", Name: "ပအိုဝ်ႏဘာႏသာႏ", LocalName: "Pa'O", Dir: "ltr"},
+	"bm":           {Code: "bm", Name: "bamanankan", LocalName: "Bambara", Dir: "ltr"},
+	"bn":           {Code: "bn", Name: "বাংলা", LocalName: "Bangla", Dir: "ltr"},
+	"bo":           {Code: "bo", Name: "བོད་ཡིག", LocalName: "Tibetan", Dir: "ltr"},
+	"bpy":          {Code: "bpy", Name: "বিষ্ণুপ্রিয়া মণিপুরী", LocalName: "Bishnupriya", Dir: "ltr"},
+	"br":           {Code: "br", Name: "brezhoneg", LocalName: "Breton", Dir: "ltr"},
+	"bs":           {Code: "bs", Name: "bosanski", LocalName: "Bosnian", Dir: "ltr"},
+	"btm":          {Code: "btm", Name: "Batak Mandailing", LocalName: "Batak Mandailing", Dir: "ltr"},
+	"bug":          {Code: "bug", Name: "Basa Ugi", LocalName: "Buginese", Dir: "ltr"},
+	"bxr":          {Code: "bxr", Name: "буряад", LocalName: "Russia Buriat", Dir: "ltr"},
+	"ca":           {Code: "ca", Name: "català", LocalName: "Catalan", Dir: "ltr"},
+	"cbk-zam":      {Code: "cbk-zam", Name: "Chavacano de Zamboanga", LocalName: "Chavacano", Dir: "ltr"},
+	"cdo":          {Code: "cdo", Name: "閩東語 / Mìng-dĕ̤ng-ngṳ̄", LocalName: "Mindong", Dir: "ltr"},
+	"ce":           {Code: "ce", Name: "нохчийн", LocalName: "Chechen", Dir: "ltr"},
+	"ceb":          {Code: "ceb", Name: "Cebuano", LocalName: "Cebuano", Dir: "ltr"},
+	"ch":           {Code: "ch", Name: "Chamoru", LocalName: "Chamorro", Dir: "ltr"},
+	"cho":          {Code: "cho", Name: "Chahta'", LocalName: "Choctaw", Dir: "ltr"},
+	"chr":          {Code: "chr", Name: "ᏣᎳᎩ", LocalName: "Cherokee", Dir: "ltr"},
+	"chy":          {Code: "chy", Name: "Tsetsêhestâhese", LocalName: "Cheyenne", Dir: "ltr"},
+	"ckb":          {Code: "ckb", Name: "کوردی", LocalName: "Central Kurdish", Dir: "rtl"},
+	"co":           {Code: "co", Name: "corsu", LocalName: "Corsican", Dir: "ltr"},
+	"cr":           {Code: "cr", Name: "Nēhiyawēwin / ᓀᐦᐃᔭᐍᐏᐣ", LocalName: "Cree", Dir: "ltr"},
+	"crh":          {Code: "crh", Name: "qırımtatarca", LocalName: "Crimean Tatar", Dir: "ltr"},
+	"cs":           {Code: "cs", Name: "čeština", LocalName: "Czech", Dir: "ltr"},
+	"csb":          {Code: "csb", Name: "kaszëbsczi", LocalName: "Kashubian", Dir: "ltr"},
+	"cu":           {Code: "cu", Name: "словѣньскъ / ⰔⰎⰑⰂⰡⰐⰠⰔⰍⰟ", LocalName: "Church Slavic", Dir: "ltr"},
+	"cv":           {Code: "cv", Name: "чӑвашла", LocalName: "Chuvash", Dir: "ltr"},
+	"cy":           {Code: "cy", Name: "Cymraeg", LocalName: "Welsh", Dir: "ltr"},
+	"da":           {Code: "da", Name: "dansk", LocalName: "Danish", Dir: "ltr"},
+	"dag":          {Code: "dag", Name: "dagbanli", LocalName: "Dagbani", Dir: "ltr"},
+	"de":           {Code: "de", Name: "Deutsch", LocalName: "German", Dir: "ltr"},
+	"dga":          {Code: "dga", Name: "Dagaare", LocalName: "Southern Dagaare", Dir: "ltr"},
+	"din":          {Code: "din", Name: "Thuɔŋjäŋ", LocalName: "Dinka", Dir: "ltr"},
+	"diq":          {Code: "diq", Name: "Zazaki", LocalName: "Zazaki", Dir: "ltr"},
+	"dsb":          {Code: "dsb", Name: "dolnoserbski", LocalName: "Lower Sorbian", Dir: "ltr"},
+	"dtp":          {Code: "dtp", Name: "Kadazandusun", LocalName: "Central Dusun", Dir: "ltr"},
+	"dty":          {Code: "dty", Name: "डोटेली", LocalName: "Doteli", Dir: "ltr"},
+	"dv":           {Code: "dv", Name: "ދިވެހިބަސް", LocalName: "Divehi", Dir: "rtl"},
+	"dz":           {Code: "dz", Name: "ཇོང་ཁ", LocalName: "Dzongkha", Dir: "ltr"},
+	"ee":           {Code: "ee", Name: "eʋegbe", LocalName: "Ewe", Dir: "ltr"},
+	"el":           {Code: "el", Name: "Ελληνικά", LocalName: "Greek", Dir: "ltr"},
+	"eml":          {Code: "eml", Name: "emiliàn e rumagnòl", LocalName: "Emiliano-Romagnolo", Dir: "ltr"},
+	"en":           {Code: "en", Name: "English", LocalName: "English", Dir: "ltr"},
+	"eo":           {Code: "eo", Name: "Esperanto", LocalName: "Esperanto", Dir: "ltr"},
+	"es":           {Code: "es", Name: "español", LocalName: "Spanish", Dir: "ltr"},
+	"et":           {Code: "et", Name: "eesti", LocalName: "Estonian", Dir: "ltr"},
+	"eu":           {Code: "eu", Name: "euskara", LocalName: "Basque", Dir: "ltr"},
+	"ext":          {Code: "ext", Name: "estremeñu", LocalName: "Extremaduran", Dir: "ltr"},
+	"fa":           {Code: "fa", Name: "فارسی", LocalName: "Persian", Dir: "rtl"},
+	"fat":          {Code: "fat", Name: "mfantse", LocalName: "Fanti", Dir: "ltr"},
+	"ff":           {Code: "ff", Name: "Fulfulde", LocalName: "Fula", Dir: "ltr"},
+	"fi":           {Code: "fi", Name: "suomi", LocalName: "Finnish", Dir: "ltr"},
+	"fiu-vro":      {Code: "fiu-vro", Name: "võro", LocalName: "Võro", Dir: "ltr"},
+	"fj":           {Code: "fj", Name: "Na Vosa Vakaviti", LocalName: "Fijian", Dir: "ltr"},
+	"fo":           {Code: "fo", Name: "føroyskt", LocalName: "Faroese", Dir: "ltr"},
+	"fon":          {Code: "fon", Name: "fɔ̀ngbè", LocalName: "Fon", Dir: "ltr"},
+	"fr":           {Code: "fr", Name: "français", LocalName: "French", Dir: "ltr"},
+	"frp":          {Code: "frp", Name: "arpetan", LocalName: "Arpitan", Dir: "ltr"},
+	"frr":          {Code: "frr", Name: "Nordfriisk", LocalName: "Northern Frisian", Dir: "ltr"},
+	"fur":          {Code: "fur", Name: "furlan", LocalName: "Friulian", Dir: "ltr"},
+	"fy":           {Code: "fy", Name: "Frysk", LocalName: "Western Frisian", Dir: "ltr"},
+	"ga":           {Code: "ga", Name: "Gaeilge", LocalName: "Irish", Dir: "ltr"},
+	"gag":          {Code: "gag", Name: "Gagauz", LocalName: "Gagauz", Dir: "ltr"},
+	"gan":          {Code: "gan", Name: "贛語", LocalName: "Gan", Dir: "ltr"},
+	"gcr":          {Code: "gcr", Name: "kriyòl gwiyannen", LocalName: "Guianan Creole", Dir: "ltr"},
+	"gd":           {Code: "gd", Name: "Gàidhlig", LocalName: "Scottish Gaelic", Dir: "ltr"},
+	"gl":           {Code: "gl", Name: "galego", LocalName: "Galician", Dir: "ltr"},
+	"glk":          {Code: "glk", Name: "گیلکی", LocalName: "Gilaki", Dir: "rtl"},
+	"gn":           {Code: "gn", Name: "Avañe'ẽ", LocalName: "Guarani", Dir: "ltr"},
+	"gom":          {Code: "gom", Name: "गोंयची कोंकणी / Gõychi Konknni", LocalName: "Goan Konkani", Dir: "ltr"},
+	"gor":          {Code: "gor", Name: "Bahasa Hulontalo", LocalName: "Gorontalo", Dir: "ltr"},
+	"got":          {Code: "got", Name: "𐌲𐌿𐍄𐌹𐍃𐌺", LocalName: "Gothic", Dir: "ltr"},
+	"gpe":          {Code: "gpe", Name: "Ghanaian Pidgin", LocalName: "Ghanaian Pidgin", Dir: "ltr"},
+	"gsw":          {Code: "gsw", Name: "Alemannisch", LocalName: "Swiss German", Dir: "ltr"},
+	"gu":           {Code: "gu", Name: "ગુજરાતી", LocalName: "Gujarati", Dir: "ltr"},
+	"guc":          {Code: "guc", Name: "wayuunaiki", LocalName: "Wayuu", Dir: "ltr"},
+	"gur":          {Code: "gur", Name: "farefare", LocalName: "Frafra", Dir: "ltr"},
+	"guw":          {Code: "guw", Name: "gungbe", LocalName: "Gun", Dir: "ltr"},
+	"gv":           {Code: "gv", Name: "Gaelg", LocalName: "Manx", Dir: "ltr"},
+	"ha":           {Code: "ha", Name: "Hausa", LocalName: "Hausa", Dir: "ltr"},
+	"hak":          {Code: "hak", Name: "客家語/Hak-kâ-ngî", LocalName: "Hakka Chinese", Dir: "ltr"},
+	"haw":          {Code: "haw", Name: "Hawaiʻi", LocalName: "Hawaiian", Dir: "ltr"},
+	"he":           {Code: "he", Name: "עברית", LocalName: "Hebrew", Dir: "rtl"},
+	"hi":           {Code: "hi", Name: "हिन्दी", LocalName: "Hindi", Dir: "ltr"},
+	"hif":          {Code: "hif", Name: "Fiji Hindi", LocalName: "Fiji Hindi", Dir: "ltr"},
+	"ho":           {Code: "ho", Name: "Hiri Motu", LocalName: "Hiri Motu", Dir: "ltr"},
+	"hr":           {Code: "hr", Name: "hrvatski", LocalName: "Croatian", Dir: "ltr"},
+	"hsb":          {Code: "hsb", Name: "hornjoserbsce", LocalName: "Upper Sorbian", Dir: "ltr"},
+	"ht":           {Code: "ht", Name: "Kreyòl ayisyen", LocalName: "Haitian Creole", Dir: "ltr"},
+	"hu":           {Code: "hu", Name: "magyar", LocalName: "Hungarian", Dir: "ltr"},
+	"hy":           {Code: "hy", Name: "հայերեն", LocalName: "Armenian", Dir: "ltr"},
+	"hyw":          {Code: "hyw", Name: "Արեւմտահայերէն", LocalName: "Western Armenian", Dir: "ltr"},
+	"hz":           {Code: "hz", Name: "Otsiherero", LocalName: "Herero", Dir: "ltr"},
+	"ia":           {Code: "ia", Name: "interlingua", LocalName: "Interlingua", Dir: "ltr"},
+	"iba":          {Code: "iba", Name: "Jaku Iban", LocalName: "Iban", Dir: "ltr"},
+	"id":           {Code: "id", Name: "Bahasa Indonesia", LocalName: "Indonesian", Dir: "ltr"},
+	"ie":           {Code: "ie", Name: "Interlingue", LocalName: "Interlingue", Dir: "ltr"},
+	"ig":           {Code: "ig", Name: "Igbo", LocalName: "Igbo", Dir: "ltr"},
+	"igl":          {Code: "igl", Name: "Igala", LocalName: "Igala", Dir: "ltr"},
+	"ii":           {Code: "ii", Name: "ꆇꉙ", LocalName: "Sichuan Yi", Dir: "ltr"},
+	"ik":           {Code: "ik", Name: "Iñupiatun", LocalName: "Inupiaq", Dir: "ltr"},
+	"ilo":          {Code: "ilo", Name: "Ilokano", LocalName: "Iloko", Dir: "ltr"},
+	"inh":          {Code: "inh", Name: "гӀалгӀай", LocalName: "Ingush", Dir: "ltr"},
+	"io":           {Code: "io", Name: "Ido", LocalName: "Ido", Dir: "ltr"},
+	"is":           {Code: "is", Name: "íslenska", LocalName: "Icelandic", Dir: "ltr"},
+	"it":           {Code: "it", Name: "italiano", LocalName: "Italian", Dir: "ltr"},
+	"iu":           {Code: "iu", Name: "ᐃᓄᒃᑎᑐᑦ / inuktitut", LocalName: "Inuktitut", Dir: "ltr"},
+	"ja":           {Code: "ja", Name: "日本語", LocalName: "Japanese", Dir: "ltr"},
+	"jam":          {Code: "jam", Name: "Patois", LocalName: "Jamaican Creole English", Dir: "ltr"},
+	"jbo":          {Code: "jbo", Name: "la .lojban.", LocalName: "Lojban", Dir: "ltr"},
+	"jv":           {Code: "jv", Name: "Jawa", LocalName: "Javanese", Dir: "ltr"},
+	"ka":           {Code: "ka", Name: "ქართული", LocalName: "Georgian", Dir: "ltr"},
+	"kaa":          {Code: "kaa", Name: "Qaraqalpaqsha", LocalName: "Kara-Kalpak", Dir: "ltr"},
+	"kab":          {Code: "kab", Name: "Taqbaylit", LocalName: "Kabyle", Dir: "ltr"},
+	"kbd":          {Code: "kbd", Name: "адыгэбзэ", LocalName: "Kabardian", Dir: "ltr"},
+	"kbp":          {Code: "kbp", Name: "Kabɩyɛ", LocalName: "Kabiye", Dir: "ltr"},
+	"kcg":          {Code: "kcg", Name: "Tyap", LocalName: "Tyap", Dir: "ltr"},
+	"kg":           {Code: "kg", Name: "Kongo", LocalName: "Kongo", Dir: "ltr"},
+	"kge":          {Code: "kge", Name: "Kumoring", LocalName: "Komering", Dir: "ltr"},
+	"ki":           {Code: "ki", Name: "Gĩkũyũ", LocalName: "Kikuyu", Dir: "ltr"},
+	"kj":           {Code: "kj", Name: "Kwanyama", LocalName: "Kuanyama", Dir: "ltr"},
+	"kk":           {Code: "kk", Name: "қазақша", LocalName: "Kazakh", Dir: "ltr"},
+	"kl":           {Code: "kl", Name: "kalaallisut", LocalName: "Kalaallisut", Dir: "ltr"},
+	"km":           {Code: "km", Name: "ភាសាខ្មែរ", LocalName: "Khmer", Dir: "ltr"},
+	"kn":           {Code: "kn", Name: "ಕನ್ನಡ", LocalName: "Kannada", Dir: "ltr"},
+	"knc":          {Code: "knc", Name: "Yerwa Kanuri", LocalName: "Central Kanuri", Dir: "ltr"},
+	"ko":           {Code: "ko", Name: "한국어", LocalName: "Korean", Dir: "ltr"},
+	"koi":          {Code: "koi", Name: "перем коми", LocalName: "Komi-Permyak", Dir: "ltr"},
+	"kr":           {Code: "kr", Name: "kanuri", LocalName: "Kanuri", Dir: "ltr"},
+	"krc":          {Code: "krc", Name: "къарачай-малкъар", LocalName: "Karachay-Balkar", Dir: "ltr"},
+	"ks":           {Code: "ks", Name: "कॉशुर / کٲشُر", LocalName: "Kashmiri", Dir: "rtl"},
+	"ksh":          {Code: "ksh", Name: "Ripoarisch", LocalName: "Colognian", Dir: "ltr"},
+	"ku":           {Code: "ku", Name: "kurdî", LocalName: "Kurdish", Dir: "ltr"},
+	"kus":          {Code: "kus", Name: "Kʋsaal", LocalName: "Kusaal", Dir: "ltr"},
+	"kv":           {Code: "kv", Name: "коми", LocalName: "Komi", Dir: "ltr"},
+	"kw":           {Code: "kw", Name: "kernowek", LocalName: "Cornish", Dir: "ltr"},
+	"ky":           {Code: "ky", Name: "кыргызча", LocalName: "Kyrgyz", Dir: "ltr"},
+	"la":           {Code: "la", Name: "Latina", LocalName: "Latin", Dir: "ltr"},
+	"lad":          {Code: "lad", Name: "Ladino", LocalName: "Ladino", Dir: "ltr"},
+	"lb":           {Code: "lb", Name: "Lëtzebuergesch", LocalName: "Luxembourgish", Dir: "ltr"},
+	"lbe":          {Code: "lbe", Name: "лакку", LocalName: "Lak", Dir: "ltr"},
+	"lez":          {Code: "lez", Name: "лезги", LocalName: "Lezghian", Dir: "ltr"},
+	"lfn":          {Code: "lfn", Name: "Lingua Franca Nova", LocalName: "Lingua Franca Nova", Dir: "ltr"},
+	"lg":           {Code: "lg", Name: "Luganda", LocalName: "Ganda", Dir: "ltr"},
+	"li":           {Code: "li", Name: "Limburgs", LocalName: "Limburgish", Dir: "ltr"},
+	"lij":          {Code: "lij", Name: "Ligure", LocalName: "Ligurian", Dir: "ltr"},
+	"lld":          {Code: "lld", Name: "Ladin", LocalName: "Ladin", Dir: "ltr"},
+	"lmo":          {Code: "lmo", Name: "lombard", LocalName: "Lombard", Dir: "ltr"},
+	"ln":           {Code: "ln", Name: "lingála", LocalName: "Lingala", Dir: "ltr"},
+	"lo":           {Code: "lo", Name: "ລາວ", LocalName: "Lao", Dir: "ltr"},
+	"lrc":          {Code: "lrc", Name: "لۊری شومالی", LocalName: "Northern Luri", Dir: "rtl"},
+	"lt":           {Code: "lt", Name: "lietuvių", LocalName: "Lithuanian", Dir: "ltr"},
+	"ltg":          {Code: "ltg", Name: "latgaļu", LocalName: "Latgalian", Dir: "ltr"},
+	"lv":           {Code: "lv", Name: "latviešu", LocalName: "Latvian", Dir: "ltr"},
+	"lzh":          {Code: "lzh", Name: "文言", LocalName: "Literary Chinese", Dir: "ltr"},
+	"mad":          {Code: "mad", Name: "Madhurâ", LocalName: "Madurese", Dir: "ltr"},
+	"mai":          {Code: "mai", Name: "मैथिली", LocalName: "Maithili", Dir: "ltr"},
+	"map-bms":      {Code: "map-bms", Name: "Basa Banyumasan", LocalName: "Banyumasan", Dir: "ltr"},
+	"mdf":          {Code: "mdf", Name: "мокшень", LocalName: "Moksha", Dir: "ltr"},
+	"mg":           {Code: "mg", Name: "Malagasy", LocalName: "Malagasy", Dir: "ltr"},
+	"mh":           {Code: "mh", Name: "Ebon", LocalName: "Marshallese", Dir: "ltr"},
+	"mhr":          {Code: "mhr", Name: "олык марий", LocalName: "Eastern Mari", Dir: "ltr"},
+	"mi":           {Code: "mi", Name: "Māori", LocalName: "Māori", Dir: "ltr"},
+	"min":          {Code: "min", Name: "Minangkabau", LocalName: "Minangkabau", Dir: "ltr"},
+	"mk":           {Code: "mk", Name: "македонски", LocalName: "Macedonian", Dir: "ltr"},
+	"ml":           {Code: "ml", Name: "മലയാളം", LocalName: "Malayalam", Dir: "ltr"},
+	"mn":           {Code: "mn", Name: "монгол", LocalName: "Mongolian", Dir: "ltr"},
+	"mni":          {Code: "mni", Name: "ꯃꯤꯇꯩ ꯂꯣꯟ", LocalName: "Manipuri", Dir: "ltr"},
+	"mnw":          {Code: "mnw", Name: "ဘာသာ မန်", LocalName: "Mon", Dir: "ltr"},
+	"mo":           {Code: "mo", Name: "молдовеняскэ", LocalName: "Moldovan", Dir: "ltr"},
+	"mos":          {Code: "mos", Name: "moore", LocalName: "Mossi", Dir: "ltr"},
+	"mr":           {Code: "mr", Name: "मराठी", LocalName: "Marathi", Dir: "ltr"},
+	"mrj":          {Code: "mrj", Name: "кырык мары", LocalName: "Western Mari", Dir: "ltr"},
+	"ms":           {Code: "ms", Name: "Bahasa Melayu", LocalName: "Malay", Dir: "ltr"},
+	"mt":           {Code: "mt", Name: "Malti", LocalName: "Maltese", Dir: "ltr"},
+	"mus":          {Code: "mus", Name: "Mvskoke", LocalName: "Muscogee", Dir: "ltr"},
+	"mwl":          {Code: "mwl", Name: "Mirandés", LocalName: "Mirandese", Dir: "ltr"},
+	"my":           {Code: "my", Name: "မြန်မာဘာသာ", LocalName: "Burmese", Dir: "ltr"},
+	"myv":          {Code: "myv", Name: "эрзянь", LocalName: "Erzya", Dir: "ltr"},
+	"mzn":          {Code: "mzn", Name: "مازِرونی", LocalName: "Mazanderani", Dir: "rtl"},
+	"na":           {Code: "na", Name: "Dorerin Naoero", LocalName: "Nauru", Dir: "ltr"},
+	"nah":          {Code: "nah", Name: "Nāhuatl", LocalName: "Nāhuatl", Dir: "ltr"},
+	"nan":          {Code: "nan", Name: "Bân-lâm-gú", LocalName: "Minnan", Dir: "ltr"},
+	"nap":          {Code: "nap", Name: "Napulitano", LocalName: "Neapolitan", Dir: "ltr"},
+	"nds":          {Code: "nds", Name: "Plattdüütsch", LocalName: "Low German", Dir: "ltr"},
+	"nds-nl":       {Code: "nds-nl", Name: "Nedersaksies", LocalName: "Low Saxon", Dir: "ltr"},
+	"ne":           {Code: "ne", Name: "नेपाली", LocalName: "Nepali", Dir: "ltr"},
+	"new":          {Code: "new", Name: "नेपाल भाषा", LocalName: "Newari", Dir: "ltr"},
+	"ng":           {Code: "ng", Name: "Oshiwambo", LocalName: "Ndonga", Dir: "ltr"},
+	"nia":          {Code: "nia", Name: "Li Niha", LocalName: "Nias", Dir: "ltr"},
+	"nl":           {Code: "nl", Name: "Nederlands", LocalName: "Dutch", Dir: "ltr"},
+	"nn":           {Code: "nn", Name: "norsk nynorsk", LocalName: "Norwegian Nynorsk", Dir: "ltr"},
+	"no":           {Code: "no", Name: "norsk", LocalName: "Norwegian", Dir: "ltr"},
+	"nov":          {Code: "nov", Name: "Novial", LocalName: "Novial", Dir: "ltr"},
+	"nqo":          {Code: "nqo", Name: "ߒߞߏ", LocalName: "N’Ko", Dir: "rtl"},
+	"nr":           {Code: "nr", Name: "isiNdebele seSewula", LocalName: "South Ndebele", Dir: "ltr"},
+	"nrm":          {Code: "nrm", Name: "Nouormand", LocalName: "Norman", Dir: "ltr"},
+	"nso":          {Code: "nso", Name: "Sesotho sa Leboa", LocalName: "Northern Sotho", Dir: "ltr"},
+	"nup":          {Code: "nup", Name: "Nupe", LocalName: "Nupe", Dir: "ltr"},
+	"nv":           {Code: "nv", Name: "Diné bizaad", LocalName: "Navajo", Dir: "ltr"},
+	"ny":           {Code: "ny", Name: "Chi-Chewa", LocalName: "Nyanja", Dir: "ltr"},
+	"oc":           {Code: "oc", Name: "occitan", LocalName: "Occitan", Dir: "ltr"},
+	"olo":          {Code: "olo", Name: "livvinkarjala", LocalName: "Livvi-Karelian", Dir: "ltr"},
+	"om":           {Code: "om", Name: "Oromoo", LocalName: "Oromo", Dir: "ltr"},
+	"or":           {Code: "or", Name: "ଓଡ଼ିଆ", LocalName: "Odia", Dir: "ltr"},
+	"os":           {Code: "os", Name: "ирон", LocalName: "Ossetic", Dir: "ltr"},
+	"pa":           {Code: "pa", Name: "ਪੰਜਾਬੀ", LocalName: "Punjabi", Dir: "ltr"},
+	"pag":          {Code: "pag", Name: "Pangasinan", LocalName: "Pangasinan", Dir: "ltr"},
+	"pam":          {Code: "pam", Name: "Kapampangan", LocalName: "Pampanga", Dir: "ltr"},
+	"pap":          {Code: "pap", Name: "Papiamentu", LocalName: "Papiamento", Dir: "ltr"},
+	"pcd":          {Code: "pcd", Name: "Picard", LocalName: "Picard", Dir: "ltr"},
+	"pcm":          {Code: "pcm", Name: "Naijá", LocalName: "Nigerian Pidgin", Dir: "ltr"},
+	"pdc":          {Code: "pdc", Name: "Deitsch", LocalName: "Pennsylvania German", Dir: "ltr"},
+	"pfl":          {Code: "pfl", Name: "Pälzisch", LocalName: "Palatine German", Dir: "ltr"},
+	"pi":           {Code: "pi", Name: "पालि", LocalName: "Pali", Dir: "ltr"},
+	"pih":          {Code: "pih", Name: "Norfuk / Pitkern", LocalName: "Norfuk / Pitkern", Dir: "ltr"},
+	"pl":           {Code: "pl", Name: "polski", LocalName: "Polish", Dir: "ltr"},
+	"pms":          {Code: "pms", Name: "Piemontèis", LocalName: "Piedmontese", Dir: "ltr"},
+	"pnb":          {Code: "pnb", Name: "پنجابی", LocalName: "Western Punjabi", Dir: "rtl"},
+	"pnt":          {Code: "pnt", Name: "Ποντιακά", LocalName: "Pontic", Dir: "ltr"},
+	"ps":           {Code: "ps", Name: "پښتو", LocalName: "Pashto", Dir: "rtl"},
+	"pt":           {Code: "pt", Name: "português", LocalName: "Portuguese", Dir: "ltr"},
+	"pwn":          {Code: "pwn", Name: "pinayuanan", LocalName: "Paiwan", Dir: "ltr"},
+	"qu":           {Code: "qu", Name: "Runa Simi", LocalName: "Quechua", Dir: "ltr"},
+	"rki":          {Code: "rki", Name: "ရခိုင်", LocalName: "Arakanese", Dir: "ltr"},
+	"rm":           {Code: "rm", Name: "rumantsch", LocalName: "Romansh", Dir: "ltr"},
+	"rmy":          {Code: "rmy", Name: "romani čhib", LocalName: "Vlax Romani", Dir: "ltr"},
+	"rn":           {Code: "rn", Name: "ikirundi", LocalName: "Rundi", Dir: "ltr"},
+	"ro":           {Code: "ro", Name: "română", LocalName: "Romanian", Dir: "ltr"},
+	"roa-rup":      {Code: "roa-rup", Name: "armãneashti", LocalName: "Aromanian", Dir: "ltr"},
+	"roa-tara":     {Code: "roa-tara", Name: "tarandíne", LocalName: "Tarantino", Dir: "ltr"},
+	"rsk":          {Code: "rsk", Name: "руски", LocalName: "Pannonian Rusyn", Dir: "ltr"},
+	"ru":           {Code: "ru", Name: "русский", LocalName: "Russian", Dir: "ltr"},
+	"rue":          {Code: "rue", Name: "русиньскый", LocalName: "Rusyn", Dir: "ltr"},
+	"rup":          {Code: "rup", Name: "armãneashti", LocalName: "Aromanian", Dir: "ltr"},
+	"rw":           {Code: "rw", Name: "Ikinyarwanda", LocalName: "Kinyarwanda", Dir: "ltr"},
+	"sa":           {Code: "sa", Name: "संस्कृतम्", LocalName: "Sanskrit", Dir: "ltr"},
+	"sah":          {Code: "sah", Name: "саха тыла", LocalName: "Yakut", Dir: "ltr"},
+	"sat":          {Code: "sat", Name: "ᱥᱟᱱᱛᱟᱲᱤ", LocalName: "Santali", Dir: "ltr"},
+	"sc":           {Code: "sc", Name: "sardu", LocalName: "Sardinian", Dir: "ltr"},
+	"scn":          {Code: "scn", Name: "sicilianu", LocalName: "Sicilian", Dir: "ltr"},
+	"sco":          {Code: "sco", Name: "Scots", LocalName: "Scots", Dir: "ltr"},
+	"sd":           {Code: "sd", Name: "سنڌي", LocalName: "Sindhi", Dir: "rtl"},
+	"se":           {Code: "se", Name: "davvisámegiella", LocalName: "Northern Sami", Dir: "ltr"},
+	"sg":           {Code: "sg", Name: "Sängö", LocalName: "Sango", Dir: "ltr"},
+	"sgs":          {Code: "sgs", Name: "žemaitėška", LocalName: "Samogitian", Dir: "ltr"},
+	"sh":           {Code: "sh", Name: "srpskohrvatski / српскохрватски", LocalName: "Serbo-Croatian", Dir: "ltr"},
+	"shi":          {Code: "shi", Name: "Taclḥit", LocalName: "Tachelhit", Dir: "ltr"},
+	"shn":          {Code: "shn", Name: "ၽႃႇသႃႇတႆး", LocalName: "Shan", Dir: "ltr"},
+	"shy":          {Code: "shy", Name: "tacawit", LocalName: "Shawiya", Dir: "ltr"},
+	"si":           {Code: "si", Name: "සිංහල", LocalName: "Sinhala", Dir: "ltr"},
+	"simple":       {Code: "simple", Name: "Simple English", LocalName: "Simple English", Dir: "ltr"},
+	"sk":           {Code: "sk", Name: "slovenčina", LocalName: "Slovak", Dir: "ltr"},
+	"skr":          {Code: "skr", Name: "سرائیکی", LocalName: "Saraiki", Dir: "rtl"},
+	"sl":           {Code: "sl", Name: "slovenščina", LocalName: "Slovenian", Dir: "ltr"},
+	"sm":           {Code: "sm", Name: "Gagana Samoa", LocalName: "Samoan", Dir: "ltr"},
+	"smn":          {Code: "smn", Name: "anarâškielâ", LocalName: "Inari Sami", Dir: "ltr"},
+	"sn":           {Code: "sn", Name: "chiShona", LocalName: "Shona", Dir: "ltr"},
+	"so":           {Code: "so", Name: "Soomaaliga", LocalName: "Somali", Dir: "ltr"},
+	"sq":           {Code: "sq", Name: "shqip", LocalName: "Albanian", Dir: "ltr"},
+	"sr":           {Code: "sr", Name: "српски / srpski", LocalName: "Serbian", Dir: "ltr"},
+	"srn":          {Code: "srn", Name: "Sranantongo", LocalName: "Sranan Tongo", Dir: "ltr"},
+	"ss":           {Code: "ss", Name: "SiSwati", LocalName: "Swati", Dir: "ltr"},
+	"st":           {Code: "st", Name: "Sesotho", LocalName: "Southern Sotho", Dir: "ltr"},
+	"stq":          {Code: "stq", Name: "Seeltersk", LocalName: "Saterland Frisian", Dir: "ltr"},
+	"su":           {Code: "su", Name: "Sunda", LocalName: "Sundanese", Dir: "ltr"},
+	"sv":           {Code: "sv", Name: "svenska", LocalName: "Swedish", Dir: "ltr"},
+	"sw":           {Code: "sw", Name: "Kiswahili", LocalName: "Swahili", Dir: "ltr"},
+	"syl":          {Code: "syl", Name: "ꠍꠤꠟꠐꠤ", LocalName: "Sylheti", Dir: "ltr"},
+	"szl":          {Code: "szl", Name: "ślůnski", LocalName: "Silesian", Dir: "ltr"},
+	"szy":          {Code: "szy", Name: "Sakizaya", LocalName: "Sakizaya", Dir: "ltr"},
+	"ta":           {Code: "ta", Name: "தமிழ்", LocalName: "Tamil", Dir: "ltr"},
+	"tay":          {Code: "tay", Name: "Tayal", LocalName: "Atayal", Dir: "ltr"},
+	"tcy":          {Code: "tcy", Name: "ತುಳು", LocalName: "Tulu", Dir: "ltr"},
+	"tdd":          {Code: "tdd", Name: "ᥖᥭᥰ ᥖᥬᥲ ᥑᥨᥒᥰ", LocalName: "Tai Nuea", Dir: "ltr"},
+	"te":           {Code: "te", Name: "తెలుగు", LocalName: "Telugu", Dir: "ltr"},
+	"tet":          {Code: "tet", Name: "tetun", LocalName: "Tetum", Dir: "ltr"},
+	"tg":           {Code: "tg", Name: "тоҷикӣ", LocalName: "Tajik", Dir: "ltr"},
+	"th":           {Code: "th", Name: "ไทย", LocalName: "Thai", Dir: "ltr"},
+	"ti":           {Code: "ti", Name: "ትግርኛ", LocalName: "Tigrinya", Dir: "ltr"},
+	"tig":          {Code: "tig", Name: "ትግሬ", LocalName: "Tigre", Dir: "ltr"},
+	"tk":           {Code: "tk", Name: "Türkmençe", LocalName: "Turkmen", Dir: "ltr"},
+	"tl":           {Code: "tl", Name: "Tagalog", LocalName: "Tagalog", Dir: "ltr"},
+	"tly":          {Code: "tly", Name: "tolışi", LocalName: "Talysh", Dir: "ltr"},
+	"tn":           {Code: "tn", Name: "Setswana", LocalName: "Tswana", Dir: "ltr"},
+	"to":           {Code: "to", Name: "lea faka-Tonga", LocalName: "Tongan", Dir: "ltr"},
+	"tpi":          {Code: "tpi", Name: "Tok Pisin", LocalName: "Tok Pisin", Dir: "ltr"},
+	"tr":           {Code: "tr", Name: "Türkçe", LocalName: "Turkish", Dir: "ltr"},
+	"trv":          {Code: "trv", Name: "Seediq", LocalName: "Taroko", Dir: "ltr"},
+	"ts":           {Code: "ts", Name: "Xitsonga", LocalName: "Tsonga", Dir: "ltr"},
+	"tt":           {Code: "tt", Name: "татарча/tatarça", LocalName: "Tatar", Dir: "ltr"},
+	"tum":          {Code: "tum", Name: "chiTumbuka", LocalName: "Tumbuka", Dir: "ltr"},
+	"tw":           {Code: "tw", Name: "Twi", LocalName: "Twi", Dir: "ltr"},
+	"ty":           {Code: "ty", Name: "reo tahiti", LocalName: "Tahitian", Dir: "ltr"},
+	"tyv":          {Code: "tyv", Name: "тыва дыл", LocalName: "Tuvinian", Dir: "ltr"},
+	"udm":          {Code: "udm", Name: "удмурт", LocalName: "Udmurt", Dir: "ltr"},
+	"ug":           {Code: "ug", Name: "ئۇيغۇرچە / Uyghurche", LocalName: "Uyghur", Dir: "rtl"},
+	"uk":           {Code: "uk", Name: "українська", LocalName: "Ukrainian", Dir: "ltr"},
+	"ur":           {Code: "ur", Name: "اردو", LocalName: "Urdu", Dir: "rtl"},
+	"uz":           {Code: "uz", Name: "oʻzbekcha/ўзбекча", LocalName: "Uzbek", Dir: "ltr"},
+	"ve":           {Code: "ve", Name: "Tshivenda", LocalName: "Venda", Dir: "ltr"},
+	"vec":          {Code: "vec", Name: "vèneto", LocalName: "Venetian", Dir: "ltr"},
+	"vep":          {Code: "vep", Name: "vepsän kel’", LocalName: "Veps", Dir: "ltr"},
+	"vi":           {Code: "vi", Name: "Tiếng Việt", LocalName: "Vietnamese", Dir: "ltr"},
+	"vls":          {Code: "vls", Name: "West-Vlams", LocalName: "West Flemish", Dir: "ltr"},
+	"vo":           {Code: "vo", Name: "Volapük", LocalName: "Volapük", Dir: "ltr"},
+	"vro":          {Code: "vro", Name: "võro", LocalName: "Võro", Dir: "ltr"},
+	"wa":           {Code: "wa", Name: "walon", LocalName: "Walloon", Dir: "ltr"},
+	"war":          {Code: "war", Name: "Winaray", LocalName: "Waray", Dir: "ltr"},
+	"wo":           {Code: "wo", Name: "Wolof", LocalName: "Wolof", Dir: "ltr"},
+	"wuu":          {Code: "wuu", Name: "吴语", LocalName: "Wu Chinese", Dir: "ltr"},
+	"xal":          {Code: "xal", Name: "хальмг", LocalName: "Kalmyk", Dir: "ltr"},
+	"xh":           {Code: "xh", Name: "isiXhosa", LocalName: "Xhosa", Dir: "ltr"},
+	"xmf":          {Code: "xmf", Name: "მარგალური", LocalName: "Mingrelian", Dir: "ltr"},
+	"yi":           {Code: "yi", Name: "ייִדיש", LocalName: "Yiddish", Dir: "rtl"},
+	"yo":           {Code: "yo", Name: "Yorùbá", LocalName: "Yoruba", Dir: "ltr"},
+	"yue":          {Code: "yue", Name: "粵語", LocalName: "Cantonese", Dir: "ltr"},
+	"za":           {Code: "za", Name: "Vahcuengh", LocalName: "Zhuang", Dir: "ltr"},
+	"zea":          {Code: "zea", Name: "Zeêuws", LocalName: "Zeelandic", Dir: "ltr"},
+	"zgh":          {Code: "zgh", Name: "ⵜⴰⵎⴰⵣⵉⵖⵜ ⵜⴰⵏⴰⵡⴰⵢⵜ", LocalName: "Standard Moroccan Tamazight", Dir: "ltr"},
+	"zh":           {Code: "zh", Name: "中文", LocalName: "Chinese", Dir: "ltr"},
+	"zh-classical": {Code: "zh-classical", Name: "文言", LocalName: "Classical Chinese", Dir: "ltr"},
+	"zh-min-nan":   {Code: "zh-min-nan", Name: "Bân-lâm-gú", LocalName: "Chinese (Min Nan)", Dir: "ltr"},
+	"zh-yue":       {Code: "zh-yue", Name: "粵語", LocalName: "Cantonese", Dir: "ltr"},
+	"zu":           {Code: "zu", Name: "isiZulu", LocalName: "Zulu", Dir: "ltr"},
+}
+
+// LookupLanguage returns the language with the given wiki code, like "fr".
+func LookupLanguage(code string) (Language, bool) {
+	language, found := languages[code]
+	return language, found
+}
+
+func IsSupportedLanguage(code string) bool {
+	_, found := languages[code]
+	return found
+}
+
+func UnsupportedLanguage(code string) bool {
+	return !IsSupportedLanguage(code)
+}
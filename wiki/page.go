@@ -0,0 +1,224 @@
+package wiki
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// MaxTitles is how many titles the query API accepts in one request.
+const MaxTitles = 50
+
+// Page is an article along with what wt needs to know about it.
+type Page struct {
+	Title string `json:"title"`
+	Url   string `json:"url"`
+	// WikidataID is the id of the Wikidata item about the article's topic,
+	// like "Q5419" for Egg salad, if there is one.
+	WikidataID string `json:"wikidata_id,omitempty"`
+	// Disambiguation pages list articles on topics of the same name.
+	// Their language links lead to other disambiguation pages.
+	Disambiguation bool `json:"disambiguation,omitempty"`
+	// Normalized is the requested title the way the API normalized it, e.g.
+	// "Egg salad" for "egg_salad", if that made a difference.
+	Normalized string `json:"normalized,omitempty"`
+	// Redirect is the redirect that led from the requested title to the
+	// page, if any.
+	Redirect *Redirect `json:"redirect,omitempty"`
+	// LangLinks are sorted by language code.
+	LangLinks []LangLink `json:"langlinks"`
+}
+
+// Page returns the lang wiki article titled title.
+func (c *Client) Page(ctx context.Context, lang, title string) (page *Page, err error) {
+	err = c.cached("page", lang, title, &page, func() (err error) {
+		pages, missing, err := c.pages(ctx, lang, []string{title})
+		if err != nil {
+			return err
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf(`%w: "%s" (%s)`, ErrMissing, title, lang)
+		}
+		var found bool
+		if page, found = pages[title]; !found {
+			return fmt.Errorf(`No results for "%s"`, title)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortLangLinks(page.LangLinks)
+	return page, nil
+}
+
+// Pages is Page for many titles of the lang wiki, asking for up to MaxTitles
+// titles per request. The result is keyed by the given titles.
+// Missing pages, and in offline mode titles that aren't cached, are left out.
+func (c *Client) Pages(ctx context.Context, lang string, titles []string) (map[string]*Page, error) {
+	pagesByTitle := make(map[string]*Page, len(titles))
+	var toFetch []string
+	for _, title := range titles {
+		if _, seen := pagesByTitle[title]; seen || slices.Contains(toFetch, title) {
+			continue
+		}
+		var page *Page
+		if c.fromCache("page", lang, title, &page) && page != nil {
+			pagesByTitle[title] = page
+		} else if !c.Offline {
+			toFetch = append(toFetch, title)
+		}
+	}
+
+	for chunk := range slices.Chunk(toFetch, MaxTitles) {
+		fetched, _, err := c.pages(ctx, lang, chunk)
+		if err != nil {
+			return nil, err
+		}
+		for title, page := range fetched {
+			pagesByTitle[title] = page
+			c.toCache("page", lang, title, page)
+		}
+	}
+
+	for _, page := range pagesByTitle {
+		sortLangLinks(page.LangLinks)
+	}
+	return pagesByTitle, nil
+}
+
+// titleMapping is an entry of the "normalized" list in query responses.
+type titleMapping struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// Redirect is a page that leads to another one, or to a section of it.
+type Redirect struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// Fragment is the section of To the redirect leads to, like "History"
+	// for a redirect to "New York City#History".
+	Fragment string `json:"tofragment,omitempty"`
+}
+
+// ErrMissing is returned for titles of pages that don't exist.
+var ErrMissing = errors.New("No such page")
+
+// pages asks for up to MaxTitles titles at once, following "continue" until
+// all language links of all pages are in. Also returns which of the titles
+// are of pages that don't exist.
+// See https://www.mediawiki.org/wiki/API:Continue.
+func (c *Client) pages(ctx context.Context, lang string, titles []string) (found map[string]*Page, missing []string, err error) {
+	params := url.Values{
+		"action":    {"query"},
+		"format":    {"json"},
+		"prop":      {"langlinks|pageprops|info"},
+		"inprop":    {"url"},
+		"llprop":    {"url|langname|autonym"},
+		"lllimit":   {"max"},
+		"ppprop":    {"wikibase_item|disambiguation"},
+		"redirects": {"1"},
+		"titles":    {strings.Join(titles, "|")},
+	}
+
+	pagesById := map[string]*Page{}
+	missingTitles := map[string]bool{}
+	var normalized []titleMapping
+	var redirects []Redirect
+	for {
+		var resp struct {
+			Continue map[string]string `json:"continue"`
+			Query    struct {
+				Normalized []titleMapping `json:"normalized"`
+				Redirects  []Redirect     `json:"redirects"`
+				Pages      map[string]struct {
+					Title     string     `json:"title"`
+					FullUrl   string     `json:"fullurl"`
+					Missing   *string    `json:"missing"`
+					LangLinks []LangLink `json:"langlinks"`
+					PageProps struct {
+						WikibaseItem   string  `json:"wikibase_item"`
+						Disambiguation *string `json:"disambiguation"`
+					} `json:"pageprops"`
+				} `json:"pages"`
+			} `json:"query"`
+		}
+		if err := c.get(ctx, lang, params, &resp); err != nil {
+			return nil, nil, err
+		}
+
+		normalized = append(normalized, resp.Query.Normalized...)
+		redirects = append(redirects, resp.Query.Redirects...)
+		for pageId, respPage := range resp.Query.Pages {
+			if respPage.Missing != nil {
+				missingTitles[respPage.Title] = true
+				continue
+			}
+			page, seen := pagesById[pageId]
+			if !seen {
+				page = &Page{Title: respPage.Title, Url: respPage.FullUrl}
+				pagesById[pageId] = page
+			}
+			if respPage.PageProps.WikibaseItem != "" {
+				page.WikidataID = respPage.PageProps.WikibaseItem
+			}
+			if respPage.PageProps.Disambiguation != nil {
+				page.Disambiguation = true
+			}
+			// each continuation adds links to pages seen before
+			page.LangLinks = append(page.LangLinks, respPage.LangLinks...)
+		}
+
+		if len(resp.Continue) == 0 {
+			break
+		}
+		for k, v := range resp.Continue {
+			params.Set(k, v)
+		}
+	}
+
+	pagesByTitle := map[string]*Page{}
+	for _, page := range pagesById {
+		pagesByTitle[page.Title] = page
+	}
+	found = make(map[string]*Page, len(titles))
+	for _, title := range titles {
+		resolved, normalizedTitle, redirect := resolveTitle(title, normalized, redirects)
+		if page, ok := pagesByTitle[resolved]; ok {
+			if normalizedTitle != "" || redirect != nil {
+				// other titles may lead to the same page some other way
+				resolvedPage := *page
+				resolvedPage.Normalized, resolvedPage.Redirect = normalizedTitle, redirect
+				page = &resolvedPage
+			}
+			found[title] = page
+		} else if missingTitles[resolved] {
+			missing = append(missing, title)
+		}
+	}
+	return found, missing, nil
+}
+
+// resolveTitle follows title through the normalizations and redirects the
+// API applied to it, returning which of them did apply.
+func resolveTitle(title string, normalized []titleMapping, redirects []Redirect) (resolved, normalizedTitle string, redirect *Redirect) {
+	resolved = title
+	for _, n := range normalized {
+		if n.From == resolved {
+			resolved, normalizedTitle = n.To, n.To
+			break
+		}
+	}
+	// the API follows a single redirect, so there's at most one per title
+	for _, r := range redirects {
+		if r.From == resolved {
+			resolved, redirect = r.To, &r
+			break
+		}
+	}
+	return resolved, normalizedTitle, redirect
+}
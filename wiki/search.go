@@ -0,0 +1,144 @@
+package wiki
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+)
+
+// Candidate is an article matching a search.
+type Candidate struct {
+	Title string `json:"title"`
+	Url   string `json:"url"`
+	// Description is the short description of the article, if it has one.
+	Description string `json:"description,omitempty"`
+}
+
+// Search finds the article best matching query and returns its title and URL.
+// The title may be that of a redirect to the article, see Page.Redirect.
+// Uses opensearch API: https://www.mediawiki.org/wiki/API:Opensearch.
+func (c *Client) Search(ctx context.Context, lang, query string) (title, titleUrl string, err error) {
+	var result struct {
+		Title string `json:"title"`
+		Url   string `json:"url"`
+	}
+	err = c.cached("search", lang, query, &result, func() error {
+		candidates, err := c.search(ctx, lang, query, 1, "return")
+		if err != nil {
+			return err
+		}
+		result.Title, result.Url = candidates[0].Title, candidates[0].Url
+		return nil
+	})
+	return result.Title, result.Url, err
+}
+
+// Candidates returns up to limit articles matching query, best match first,
+// along with their descriptions.
+func (c *Client) Candidates(ctx context.Context, lang, query string, limit int) (candidates []Candidate, err error) {
+	err = c.cached("candidates", lang, fmt.Sprintf("%d/%s", limit, query), &candidates, func() (err error) {
+		candidates, err = c.search(ctx, lang, query, limit, "resolve")
+		if err != nil {
+			return err
+		}
+		return c.describeCandidates(ctx, lang, candidates)
+	})
+	return candidates, err
+}
+
+// describeCandidates fills in the descriptions opensearch left out, which
+// it does on most wikis.
+func (c *Client) describeCandidates(ctx context.Context, lang string, candidates []Candidate) error {
+	var titles []string
+	for _, candidate := range candidates {
+		if candidate.Description == "" {
+			titles = append(titles, candidate.Title)
+		}
+	}
+	if len(titles) == 0 {
+		return nil
+	}
+
+	descriptions, err := c.Descriptions(ctx, lang, titles)
+	if err != nil {
+		return err
+	}
+	for i, candidate := range candidates {
+		if candidate.Description == "" {
+			candidates[i].Description = descriptions[candidate.Title]
+		}
+	}
+	return nil
+}
+
+// search returns up to limit articles matching query, at least one.
+// Redirects are either "return"ed as is or "resolve"d to their targets.
+func (c *Client) search(ctx context.Context, lang, query string, limit int, redirects string) ([]Candidate, error) {
+	params := url.Values{
+		"action":    {"opensearch"},
+		"format":    {"json"},
+		"redirects": {redirects},
+		"limit":     {strconv.Itoa(limit)},
+		"search":    {query},
+	}
+	var raw json.RawMessage
+	if err := c.get(ctx, lang, params, &raw); err != nil {
+		return nil, err
+	}
+
+	// [query, titles, descriptions, urls]
+	loLoStr, err := listOfListsOfStrings(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse response: %w", err)
+	}
+	if len(loLoStr) != 4 || len(loLoStr[1]) != len(loLoStr[3]) {
+		return nil, fmt.Errorf("Malformed response. Expected a [4][n]string, got: %v", loLoStr)
+	}
+	if len(loLoStr[1]) == 0 {
+		return nil, fmt.Errorf(`No results for "%s"`, query)
+	}
+
+	candidates := make([]Candidate, len(loLoStr[1]))
+	for i, title := range loLoStr[1] {
+		candidates[i] = Candidate{Title: title, Url: loLoStr[3][i]}
+		if i < len(loLoStr[2]) {
+			candidates[i].Description = loLoStr[2][i]
+		}
+	}
+	return candidates, nil
+}
+
+// Useful for parsing responses in the  format of `[ string | []string ]`.
+// Idea from: https://gist.github.com/crgimenes/c3b8b4fcce8529e9201f83c8da134f32.
+func listOfListsOfStrings(r io.Reader) ([][]string, error) {
+	var anyList []any
+	if err := json.NewDecoder(r).Decode(&anyList); err != nil {
+		return nil, err
+	}
+
+	strLists := make([][]string, 0, len(anyList))
+	for _, item := range anyList {
+		switch obj := item.(type) {
+		case string:
+			strLists = append(strLists, []string{obj})
+		case []any:
+			strList := make([]string, 0, len(obj))
+			for _, v := range obj {
+				if str, ok := v.(string); ok {
+					strList = append(strList, str)
+				} else {
+					return nil, fmt.Errorf("Expected a string but got %#v", v)
+				}
+			}
+			strLists = append(strLists, strList)
+		default:
+			return nil, fmt.Errorf("Expected string or []any but got %v", obj)
+		}
+	}
+
+	return strLists, nil
+}
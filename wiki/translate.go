@@ -0,0 +1,108 @@
+package wiki
+
+import (
+	"cmp"
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// Translation is an article found in the source language along with its
+// language links.
+type Translation struct {
+	Lang  string
+	Title string
+	Url   string
+	// WikidataID is the id of the Wikidata item about the article's topic, if any.
+	WikidataID string
+	// Normalized and Redirect tell how the title searched for or asked for
+	// led to Title, see Page.
+	Normalized string
+	Redirect   *Redirect
+	// Description is a line about the article, see Client.Describe.
+	Description string
+	// LangLinks are sorted by language code and never include Lang.
+	LangLinks []LangLink
+}
+
+// Translate finds the lang wiki article matching query and gets its
+// language links.
+func (c *Client) Translate(ctx context.Context, lang, query string) (*Translation, error) {
+	title, _, err := c.Search(ctx, lang, query)
+	if err != nil {
+		return nil, err
+	}
+	return c.TranslateTitle(ctx, lang, title)
+}
+
+// TranslateTitle gets the language links of the lang wiki article titled
+// title, e.g. one of the Candidates, without searching. Redirects are
+// followed, so the Translation can have a different title. Fails with
+// ErrMissing if there's no such article.
+func (c *Client) TranslateTitle(ctx context.Context, lang, title string) (*Translation, error) {
+	page, err := c.Page(ctx, lang, title)
+	if err != nil {
+		return nil, err
+	}
+	if page.Disambiguation {
+		return nil, &DisambiguationError{Lang: lang, Title: page.Title}
+	}
+	return NewTranslation(lang, page), nil
+}
+
+// NewTranslation returns the translation of page, an article of the lang
+// wiki. If page was reached by a redirect to a section, Url leads to the
+// section.
+func NewTranslation(lang string, page *Page) *Translation {
+	t := &Translation{
+		Lang:       lang,
+		Title:      page.Title,
+		Url:        page.Url,
+		WikidataID: page.WikidataID,
+		Normalized: page.Normalized,
+		Redirect:   page.Redirect,
+		LangLinks:  page.LangLinks,
+	}
+	if section := t.Section(); section != "" {
+		// anchors use underscores for spaces, like titles in URLs
+		t.Url += (&url.URL{Fragment: strings.ReplaceAll(section, " ", "_")}).String()
+	}
+	return t
+}
+
+// Section is the section of the article a redirect led to, if any. The
+// language links are still those of the whole article.
+func (t *Translation) Section() string {
+	if t.Redirect == nil {
+		return ""
+	}
+	return t.Redirect.Fragment
+}
+
+// Missing returns the languages of langs, other than Lang, that t has no
+// links to.
+func (t *Translation) Missing(langs []string) []string {
+	var missing []string
+	for _, lang := range langs {
+		if _, found := t.Link(lang); !found && lang != t.Lang {
+			missing = append(missing, lang)
+		}
+	}
+	return missing
+}
+
+// Link returns the link to the article in lang, if there is one. Links of
+// wikis with another code for the same language, like "zh-yue" for "yue",
+// count too.
+func (t *Translation) Link(lang string) (LangLink, bool) {
+	for _, code := range []string{lang, sameLanguages[lang]} {
+		linkIdx, found := slices.BinarySearchFunc(t.LangLinks, code, func(link LangLink, lang string) int {
+			return cmp.Compare(link.Lang, lang)
+		})
+		if found && code != "" {
+			return t.LangLinks[linkIdx], true
+		}
+	}
+	return LangLink{}, false
+}
@@ -0,0 +1,216 @@
+package wiki
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// Entity is a Wikidata item, the topic that articles in different languages
+// are about.
+type Entity struct {
+	ID  string `json:"id"`
+	Url string `json:"url"`
+	// Sitelinks are the Wikipedia articles about the item, keyed by the
+	// language code of their wiki.
+	Sitelinks map[string]Sitelink `json:"sitelinks"`
+	// Labels are the names of the item, keyed by language code. There are
+	// labels in languages that have no article about the item.
+	Labels map[string]string `json:"labels"`
+	// Aliases are the other names of the item, keyed by language code.
+	Aliases map[string][]string `json:"aliases,omitempty"`
+}
+
+type Sitelink struct {
+	Title string `json:"title"`
+	Url   string `json:"url"`
+}
+
+// Entity returns the Wikidata item with the given id, like "Q5419".
+func (c *Client) Entity(ctx context.Context, id string) (*Entity, error) {
+	entities, err := c.Entities(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+	entity, found := entities[id]
+	if !found {
+		if c.Offline {
+			return nil, fmt.Errorf(`%w: wikidata "%s"`, ErrNotCached, id)
+		}
+		return nil, fmt.Errorf(`%w: "%s" (wikidata)`, ErrMissing, id)
+	}
+	return entity, nil
+}
+
+// Entities is Entity for many ids, asking for up to MaxTitles items per
+// request. Missing items, and in offline mode items that aren't cached, are
+// left out.
+// Uses https://www.wikidata.org/wiki/Special:ApiHelp/wbgetentities.
+func (c *Client) Entities(ctx context.Context, ids []string) (map[string]*Entity, error) {
+	entities := make(map[string]*Entity, len(ids))
+	var toFetch []string
+	for _, id := range ids {
+		if _, seen := entities[id]; seen || slices.Contains(toFetch, id) {
+			continue
+		}
+		var entity *Entity
+		if c.fromCache("wikidata", "", id, &entity) && entity != nil {
+			entities[id] = entity
+		} else if !c.Offline {
+			toFetch = append(toFetch, id)
+		}
+	}
+
+	for chunk := range slices.Chunk(toFetch, MaxTitles) {
+		fetched, err := c.entities(ctx, chunk)
+		if err != nil {
+			return nil, err
+		}
+		for id, entity := range fetched {
+			entities[id] = entity
+			c.toCache("wikidata", "", id, entity)
+		}
+	}
+	return entities, nil
+}
+
+func (c *Client) entities(ctx context.Context, ids []string) (map[string]*Entity, error) {
+	params := url.Values{
+		"action": {"wbgetentities"},
+		"format": {"json"},
+		"props":  {"labels|aliases|sitelinks/urls"},
+		"ids":    {strings.Join(ids, "|")},
+	}
+	var resp struct {
+		Error *struct {
+			Info string `json:"info"`
+		} `json:"error"`
+		Entities map[string]struct {
+			Missing *string `json:"missing"`
+			Labels  map[string]struct {
+				Value string `json:"value"`
+			} `json:"labels"`
+			Aliases map[string][]struct {
+				Value string `json:"value"`
+			} `json:"aliases"`
+			Sitelinks map[string]Sitelink `json:"sitelinks"`
+		} `json:"entities"`
+	}
+	if err := c.getFrom(ctx, c.WikidataURL, params, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Error != nil {
+		return nil, fmt.Errorf("Wikidata: %s", resp.Error.Info)
+	}
+
+	entities := make(map[string]*Entity, len(resp.Entities))
+	for id, respEntity := range resp.Entities {
+		if respEntity.Missing != nil {
+			continue
+		}
+		entity := &Entity{
+			ID:        id,
+			Url:       strings.TrimSuffix(c.WikidataURL, "w/api.php") + "wiki/" + id,
+			Sitelinks: map[string]Sitelink{},
+			Labels:    make(map[string]string, len(respEntity.Labels)),
+			Aliases:   make(map[string][]string, len(respEntity.Aliases)),
+		}
+		for _, sitelink := range respEntity.Sitelinks {
+			// sites are named like "enwiki" or "be_x_oldwiki", the URL has the
+			// language code; Wiktionary, Commons etc. are left out
+			u, err := url.Parse(sitelink.Url)
+			if err != nil {
+				continue
+			}
+			if lang, ok := strings.CutSuffix(u.Host, ".wikipedia.org"); ok {
+				entity.Sitelinks[lang] = sitelink
+			}
+		}
+		for lang, label := range respEntity.Labels {
+			entity.Labels[lang] = label.Value
+		}
+		for lang, aliases := range respEntity.Aliases {
+			for _, alias := range aliases {
+				entity.Aliases[lang] = append(entity.Aliases[lang], alias.Value)
+			}
+		}
+		entities[id] = entity
+	}
+	return entities, nil
+}
+
+// WikidataTranslation returns t with its language links replaced by the
+// sitelinks of its Wikidata item, see Translation.WithEntity.
+func (c *Client) WikidataTranslation(ctx context.Context, t *Translation, labelLangs []string) (*Translation, error) {
+	if t.WikidataID == "" {
+		return nil, fmt.Errorf(`"%s" has no Wikidata item`, t.Title)
+	}
+	entity, err := c.Entity(ctx, t.WikidataID)
+	if err != nil {
+		return nil, err
+	}
+	return t.WithEntity(entity, labelLangs), nil
+}
+
+// FillFromWikidata returns t with the labels of its Wikidata item added for
+// the languages of langs it has no links to, see Translation.WithLabels.
+// Doesn't ask for the item if there's nothing to fill in.
+func (c *Client) FillFromWikidata(ctx context.Context, t *Translation, langs []string) (*Translation, error) {
+	if t.WikidataID == "" || len(t.Missing(langs)) == 0 {
+		return t, nil
+	}
+	entity, err := c.Entity(ctx, t.WikidataID)
+	if err != nil {
+		return t, err
+	}
+	return t.WithLabels(entity, langs), nil
+}
+
+// WithEntity returns t with its language links replaced by the articles
+// about e in other Wikipedias, which are canonical where the language links
+// of wikis disagree. Languages of labelLangs that have no article get the
+// label of e instead, if there is one, see WithLabels.
+func (t *Translation) WithEntity(e *Entity, labelLangs []string) *Translation {
+	wt := *t
+	wt.LangLinks = make([]LangLink, 0, len(e.Sitelinks))
+	for lang, sitelink := range e.Sitelinks {
+		if lang == t.Lang {
+			continue
+		}
+		link := LangLink{Lang: lang, Star: sitelink.Title, Url: sitelink.Url}
+		// sitelinks come without language names
+		if langLink, found := t.Link(lang); found {
+			link.LangName, link.Autonym = langLink.LangName, langLink.Autonym
+		}
+		wt.LangLinks = append(wt.LangLinks, withNames(link))
+	}
+	sortLangLinks(wt.LangLinks)
+	return wt.WithLabels(e, labelLangs)
+}
+
+// WithLabels returns t with links made of the labels of e for the languages
+// of langs that t has no links to, see LangLink.LabelOnly. Languages with
+// aliases but no label get the first alias as the label.
+func (t *Translation) WithLabels(e *Entity, langs []string) *Translation {
+	missing := t.Missing(langs)
+	if len(missing) == 0 {
+		return t
+	}
+	wt := *t
+	wt.LangLinks = slices.Clone(t.LangLinks)
+	for _, lang := range missing {
+		names := e.Aliases[lang]
+		if label := e.Labels[lang]; label != "" {
+			names = append([]string{label}, names...)
+		}
+		if len(names) == 0 {
+			continue
+		}
+		link := LangLink{Lang: lang, Star: names[0], Url: e.Url, LabelOnly: true, Aliases: names[1:]}
+		wt.LangLinks = append(wt.LangLinks, withNames(link))
+	}
+	sortLangLinks(wt.LangLinks)
+	return &wt
+}
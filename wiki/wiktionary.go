@@ -0,0 +1,199 @@
+package wiki
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// WiktionaryBaseURL is the MediaWiki API endpoint of Wiktionary, to be used
+// as Client.BaseURL for TranslateWord and TranslateEntry.
+const WiktionaryBaseURL = "https://{lang}.wiktionary.org/w/api.php"
+
+// WiktionaryLang is the language of the only Wiktionary whose translation
+// tables can be read. Others use templates of their own, like {{trad+}} in
+// French or {{Ü}} in German.
+const WiktionaryLang = "en"
+
+// ErrNoTranslations is returned for Wiktionary entries without translation
+// tables.
+var ErrNoTranslations = errors.New("No translation tables")
+
+// TranslateWord finds the lang Wiktionary entry matching query and gets the
+// translations listed in it, which are better than language links for
+// ordinary words. lang must be WiktionaryLang.
+func (c *Client) TranslateWord(ctx context.Context, lang, query string) (*Translation, error) {
+	if err := checkWiktionaryLang(lang); err != nil {
+		return nil, err
+	}
+	title, _, err := c.Search(ctx, lang, query)
+	if err != nil {
+		return nil, err
+	}
+	return c.TranslateEntry(ctx, lang, title)
+}
+
+// TranslateEntry gets the translations listed in the lang Wiktionary entry
+// titled title, or on its translation subpage. The translations of a
+// language are in LangLinks as a link to the first one listed, with the
+// others as Aliases. lang must be WiktionaryLang.
+func (c *Client) TranslateEntry(ctx context.Context, lang, title string) (t *Translation, err error) {
+	if err := checkWiktionaryLang(lang); err != nil {
+		return nil, err
+	}
+	err = c.cached("entry", lang, title, &t, func() (err error) {
+		t, err = c.entry(ctx, lang, title)
+		return err
+	})
+	return t, err
+}
+
+func checkWiktionaryLang(lang string) error {
+	if lang != WiktionaryLang {
+		return fmt.Errorf(`Can't read the translation tables of the "%s" Wiktionary, only of the "%s" one`, lang, WiktionaryLang)
+	}
+	return nil
+}
+
+func (c *Client) entry(ctx context.Context, lang, title string) (*Translation, error) {
+	page, err := c.entryPage(ctx, lang, title)
+	if err != nil {
+		return nil, err
+	}
+	translations := wikitextTranslations(page.content)
+	// long tables are moved to a subpage, like "run/translations"
+	if translationSubpage.MatchString(page.content) {
+		subpage, err := c.entryPage(ctx, lang, page.title+"/translations")
+		if err != nil && !errors.Is(err, ErrMissing) {
+			return nil, err
+		}
+		if err == nil {
+			translations = append(translations, wikitextTranslations(subpage.content)...)
+		}
+	}
+	if len(translations) == 0 {
+		return nil, fmt.Errorf(`%w: "%s" (%s)`, ErrNoTranslations, page.title, lang)
+	}
+
+	t := &Translation{Lang: lang, Title: page.title, Url: page.url}
+	for _, tr := range translations {
+		if tr.lang == lang {
+			continue
+		}
+		// t+ marks terms that have an entry in the Wiktionary of their language
+		site := lang
+		if tr.exists {
+			site = tr.lang
+		}
+		link := withNames(LangLink{Lang: tr.lang, Star: tr.term, Url: c.entryUrl(site, tr.term)})
+		i, found := slices.BinarySearchFunc(t.LangLinks, tr.lang, func(link LangLink, lang string) int {
+			return strings.Compare(link.Lang, lang)
+		})
+		if !found {
+			t.LangLinks = slices.Insert(t.LangLinks, i, link)
+		} else if existing := &t.LangLinks[i]; existing.Star != tr.term && !slices.Contains(existing.Aliases, tr.term) {
+			existing.Aliases = append(existing.Aliases, tr.term)
+		}
+	}
+	return t, nil
+}
+
+type entryPage struct {
+	title, url, content string
+}
+
+// entryPage gets the wikitext of the lang Wiktionary page titled title.
+func (c *Client) entryPage(ctx context.Context, lang, title string) (*entryPage, error) {
+	params := url.Values{
+		"action":        {"query"},
+		"format":        {"json"},
+		"formatversion": {"2"},
+		"prop":          {"revisions|info"},
+		"rvprop":        {"content"},
+		"rvslots":       {"main"},
+		"inprop":        {"url"},
+		"redirects":     {"1"},
+		"titles":        {title},
+	}
+	var resp struct {
+		Query struct {
+			Pages []struct {
+				Title     string `json:"title"`
+				FullUrl   string `json:"fullurl"`
+				Missing   bool   `json:"missing"`
+				Revisions []struct {
+					Slots struct {
+						Main struct {
+							Content string `json:"content"`
+						} `json:"main"`
+					} `json:"slots"`
+				} `json:"revisions"`
+			} `json:"pages"`
+		} `json:"query"`
+	}
+	if err := c.get(ctx, lang, params, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Query.Pages) == 0 {
+		return nil, fmt.Errorf(`No results for "%s"`, title)
+	}
+	page := resp.Query.Pages[0]
+	if page.Missing || len(page.Revisions) == 0 {
+		return nil, fmt.Errorf(`%w: "%s" (%s)`, ErrMissing, title, lang)
+	}
+	return &entryPage{title: page.Title, url: page.FullUrl, content: page.Revisions[0].Slots.Main.Content}, nil
+}
+
+// entryUrl returns the URL of the lang wiki page titled title.
+func (c *Client) entryUrl(lang, title string) string {
+	base := strings.TrimSuffix(c.endpoint(lang), "w/api.php")
+	return base + "wiki/" + url.PathEscape(strings.ReplaceAll(title, " ", "_"))
+}
+
+// translationTemplate matches the templates in translation tables, like
+// {{t+|fr|œuf|m}}: https://en.wiktionary.org/wiki/Template:t.
+var translationTemplate = regexp.MustCompile(`\{\{(t\+?|tt\+?)\|([^{}]*)\}\}`)
+
+// translationSubpage matches the template that entries with their
+// translations on a subpage have instead of the tables:
+// https://en.wiktionary.org/wiki/Template:see_translation_subpage.
+var translationSubpage = regexp.MustCompile(`\{\{see translation subpage[|}]`)
+
+type wikitextTranslation struct {
+	lang, term string
+	// exists tells that the term has an entry in the Wiktionary of its language.
+	exists bool
+}
+
+// wikitextTranslations returns the translations in the translation tables of
+// the wikitext of a Wiktionary entry, in order of appearance.
+func wikitextTranslations(wikitext string) []wikitextTranslation {
+	var translations []wikitextTranslation
+	for _, match := range translationTemplate.FindAllStringSubmatch(wikitext, -1) {
+		var positional []string
+		for _, param := range strings.Split(match[2], "|") {
+			// named parameters like tr=, alt= or sc= don't change the term
+			if !strings.Contains(param, "=") {
+				positional = append(positional, strings.TrimSpace(param))
+			}
+		}
+		if len(positional) < 2 {
+			continue
+		}
+		// terms can be made of links to their words, like [[ovo]] [[cozido]]
+		term := strings.NewReplacer("[[", "", "]]", "").Replace(positional[1])
+		if positional[0] == "" || term == "" {
+			continue
+		}
+		translations = append(translations, wikitextTranslation{
+			lang:   positional[0],
+			term:   term,
+			exists: strings.HasSuffix(match[1], "+"),
+		})
+	}
+	return translations
+}
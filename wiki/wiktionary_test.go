@@ -0,0 +1,89 @@
+package wiki
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestWikitextTranslations(t *testing.T) {
+	wikitext := `====Translations====
+{{trans-top|oval object}}
+* French: {{t+|fr|œuf|m}}
+* Latvian: {{t|lv|ola|f}}, {{t|lv|pauts|m|alt=pauts}}
+* Portuguese: {{t+|pt|[[ovo]] [[cozido]]|m|tr=}}
+* Russian: {{t+|ru|яйцо́|n|tr=jajcó}}
+* Spanish: {{t-needed|es}}
+{{trans-bottom}}`
+
+	got := wikitextTranslations(wikitext)
+	want := []wikitextTranslation{
+		{lang: "fr", term: "œuf", exists: true},
+		{lang: "lv", term: "ola"},
+		{lang: "lv", term: "pauts"},
+		{lang: "pt", term: "ovo cozido", exists: true},
+		{lang: "ru", term: "яйцо́", exists: true},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Got %+v, want %+v", got, want)
+	}
+}
+
+func TestTranslateEntry(t *testing.T) {
+	client, _ := newTestClient(t,
+		`{"batchcomplete": true, "query": {"pages": [{"title": "egg",
+			"fullurl": "https://en.wiktionary.org/wiki/egg",
+			"revisions": [{"slots": {"main": {"content":
+				"* French: {{t+|fr|œuf|m}}\n* Latvian: {{t|lv|ola|f}}, {{t|lv|pauts|m}}\n* English: {{t|en|egg}}"}}}]}]}}`,
+	)
+
+	tr, err := client.TranslateEntry(context.Background(), "en", "egg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := langs(tr.LangLinks), []string{"fr", "lv"}; !slices.Equal(got, want) {
+		t.Errorf("Got languages %v, want %v", got, want)
+	}
+	lv, _ := tr.Link("lv")
+	if lv.Star != "ola" || !slices.Equal(lv.Aliases, []string{"pauts"}) {
+		t.Errorf("Got lv translation %s and aliases %v, want ola and [pauts]", lv.Star, lv.Aliases)
+	}
+}
+
+func TestTranslateEntrySubpage(t *testing.T) {
+	client, requests := newTestClient(t,
+		`{"batchcomplete": true, "query": {"pages": [{"title": "run",
+			"fullurl": "https://en.wiktionary.org/wiki/run",
+			"revisions": [{"slots": {"main": {"content": "====Translations====\n{{see translation subpage|Verb}}"}}}]}]}}`,
+		`{"batchcomplete": true, "query": {"pages": [{"title": "run/translations",
+			"fullurl": "https://en.wiktionary.org/wiki/run/translations",
+			"revisions": [{"slots": {"main": {"content": "* French: {{t+|fr|courir}}"}}}]}]}}`,
+	)
+
+	tr, err := client.TranslateEntry(context.Background(), "en", "run")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := (*requests)[1]["titles"]; got != "run/translations" {
+		t.Errorf("Got second request for %s, want run/translations", got)
+	}
+	if fr, _ := tr.Link("fr"); fr.Star != "courir" || tr.Url != "https://en.wiktionary.org/wiki/run" {
+		t.Errorf("Got fr translation %s of %s, want courir of the entry", fr.Star, tr.Url)
+	}
+}
+
+func TestTranslateEntryWithoutTranslations(t *testing.T) {
+	client, _ := newTestClient(t,
+		`{"batchcomplete": true, "query": {"pages": [{"title": "qwerty",
+			"fullurl": "https://en.wiktionary.org/wiki/qwerty",
+			"revisions": [{"slots": {"main": {"content": "===Noun===\n# A keyboard layout"}}}]}]}}`,
+	)
+
+	if _, err := client.TranslateEntry(context.Background(), "en", "qwerty"); !errors.Is(err, ErrNoTranslations) {
+		t.Errorf("Got error %v, want ErrNoTranslations", err)
+	}
+	if _, err := client.TranslateEntry(context.Background(), "fr", "courir"); err == nil {
+		t.Error("Got no error for the French Wiktionary")
+	}
+}